@@ -3,8 +3,12 @@ package handler
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bradleyfalzon/ghinstallation"
@@ -17,55 +21,219 @@ type GithubClient struct {
 	Expiration time.Time
 	Repos      RepoClient
 	Apps       AppsClient
+	Teams      TeamsClient
 }
 
 func (c *GithubClient) isExpired() bool {
 	return c.Expiration.Before(time.Now().Add(1 * time.Minute))
 }
 
+// GithubEndpoint is the API and upload URL of a Github instance. An empty BaseURL means github.com,
+// and the UploadURL defaults to the BaseURL (which is the case for Github Enterprise Server).
+type GithubEndpoint struct {
+	BaseURL   string `json:"baseUrl"`
+	UploadURL string `json:"uploadUrl,omitempty"`
+
+	// Credentials for the Github Apps registered on the instance, which default to the ones
+	// used for the default endpoint.
+	TokenService GithubAppCredentials `json:"tokenService,omitempty"`
+	KeyService   GithubAppCredentials `json:"keyService,omitempty"`
+
+	// SSH address (see SSHAddress) and host keys of the git host on the instance, used to verify new
+	// deploy keys. Keys for owners on an instance with neither are not verified.
+	SSHHost     string   `json:"sshHost,omitempty"`
+	SSHHostKeys []string `json:"sshHostKeys,omitempty"`
+}
+
+// GithubAppCredentials are the integration (App) ID and private key of a Github App.
+type GithubAppCredentials struct {
+	IntegrationID int64  `json:"integrationId,omitempty"`
+	PrivateKey    string `json:"privateKey,omitempty"`
+}
+
+// Returns the credentials, or the defaults if they are not set.
+func (c GithubAppCredentials) or(defaults GithubAppCredentials) GithubAppCredentials {
+	if c.IntegrationID == 0 && c.PrivateKey == "" {
+		return defaults
+	}
+	return c
+}
+
+// SSHAddress returns the address of the git host on the instance: SSHHost if set, otherwise the host of
+// BaseURL (or github.com) on port 22.
+func (e GithubEndpoint) SSHAddress() string {
+	if e.SSHHost != "" {
+		return e.SSHHost
+	}
+	if u, err := url.Parse(e.BaseURL); err == nil && u.Hostname() != "" {
+		return net.JoinHostPort(u.Hostname(), "22")
+	}
+	return "github.com:22"
+}
+
+// Create a Github client for the endpoint.
+func (e GithubEndpoint) newClient(httpClient *http.Client) (*github.Client, error) {
+	if e.BaseURL == "" {
+		return github.NewClient(httpClient), nil
+	}
+	uploadURL := e.UploadURL
+	if uploadURL == "" {
+		uploadURL = e.BaseURL
+	}
+	return github.NewEnterpriseClient(e.BaseURL, uploadURL, httpClient)
+}
+
+// GithubEndpoints configures which Github instance is used for each owner (user or org).
+type GithubEndpoints struct {
+	// Default endpoint for owners which are not listed in Owners.
+	Default GithubEndpoint
+
+	// Owners on a different Github instance than the default.
+	Owners map[string]GithubEndpoint
+}
+
+func (e GithubEndpoints) forOwner(owner string) (GithubEndpoint, bool) {
+	for o, endpoint := range e.Owners {
+		if strings.EqualFold(o, owner) {
+			return endpoint, true
+		}
+	}
+	return e.Default, false
+}
+
 // GithubApp ...
 type GithubApp struct {
+	Name          string
 	App           AppsClient
 	Installations map[string]int64
 	Clients       map[string]*GithubClient
+
+	// RateLimit configures retries for the installation clients, and when to stop making requests.
+	RateLimit RateLimitConfig
+
+	// TokenClient returns an Apps client authenticated with the given installation token, and
+	// defaults to a client for the owner's Github instance.
+	TokenClient func(owner, token string) (AppsClient, error)
+
+	// Apps clients for owners on a different Github instance than App.
+	apps      map[string]AppsClient
+	endpoints GithubEndpoints
+
+	// Remaining quota for each installation, by (lower case) owner.
+	quotas map[string]*rateLimitQuota
+
+	// mu guards Installations, apps and quotas, and clientsMu guards Clients (and is held while
+	// creating a new client so that only one installation token is created per owner).
+	mu        sync.RWMutex
+	clientsMu sync.Mutex
 }
 
-func newGithubApp(integrationID int64, privateKey string) (*GithubApp, error) {
-	tr, err := ghinstallation.NewAppsTransport(http.DefaultTransport, integrationID, []byte(privateKey))
+// Create a Github App with the default credentials, and the credentials returned by forEndpoint for
+// owners on other Github instances (if set).
+func newGithubApp(
+	name string,
+	credentials GithubAppCredentials,
+	forEndpoint func(GithubEndpoint) GithubAppCredentials,
+	endpoints GithubEndpoints,
+	rateLimit RateLimitConfig,
+) (*GithubApp, error) {
+	app := &GithubApp{
+		Name:          name,
+		RateLimit:     rateLimit,
+		Installations: make(map[string]int64),
+		Clients:       make(map[string]*GithubClient),
+		apps:          make(map[string]AppsClient),
+		endpoints:     endpoints,
+	}
+
+	client, installations, err := listInstallations(forEndpoint(endpoints.Default).or(credentials), endpoints.Default, rateLimit)
 	if err != nil {
 		return nil, err
 	}
-	client := github.NewClient(&http.Client{Transport: tr})
+	app.App = client.Apps
+	for owner, id := range installations {
+		if _, ok := endpoints.forOwner(owner); !ok {
+			app.Installations[owner] = id
+		}
+	}
 
-	// List installations (TODO: Paginate results.)
-	installations, _, err := client.Apps.ListInstallations(context.TODO(), &github.ListOptions{})
+	// Only list installations once for each Github instance
+	type instance struct {
+		client        *github.Client
+		installations map[string]int64
+	}
+	type instanceKey struct {
+		baseURL, uploadURL string
+		credentials        GithubAppCredentials
+	}
+	instances := make(map[instanceKey]*instance)
+	for owner, endpoint := range endpoints.Owners {
+		owner = strings.ToLower(owner)
+		key := instanceKey{baseURL: endpoint.BaseURL, uploadURL: endpoint.UploadURL, credentials: forEndpoint(endpoint).or(credentials)}
+		i, ok := instances[key]
+		if !ok {
+			client, installations, err := listInstallations(key.credentials, endpoint, rateLimit)
+			if err != nil {
+				return nil, err
+			}
+			i = &instance{client: client, installations: installations}
+			instances[key] = i
+		}
+		if id, ok := i.installations[owner]; ok {
+			app.Installations[owner] = id
+			app.apps[owner] = i.client.Apps
+		}
+	}
+	return app, nil
+}
+
+// Create a client authenticated as the Github App and list its installations by (lower case) owner.
+func listInstallations(credentials GithubAppCredentials, endpoint GithubEndpoint, rateLimit RateLimitConfig) (*github.Client, map[string]int64, error) {
+	base := &rateLimitTransport{base: http.DefaultTransport, config: rateLimit}
+	tr, err := ghinstallation.NewAppsTransport(base, credentials.IntegrationID, []byte(credentials.PrivateKey))
 	if err != nil {
-		return nil, fmt.Errorf("failed to list installations: %s", err)
+		return nil, nil, err
+	}
+	client, err := endpoint.newClient(&http.Client{Transport: tr})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create client for '%s': %s", endpoint.BaseURL, err)
+	}
+	tr.BaseURL = strings.TrimSuffix(client.BaseURL.String(), "/")
+
+	var installations []*github.Installation
+	err = paginate(func(opts *github.ListOptions) (*github.Response, error) {
+		page, res, err := client.Apps.ListInstallations(context.Background(), opts)
+		installations = append(installations, page...)
+		return res, err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list installations: %s", err)
 	}
 
 	installs := make(map[string]int64, len(installations))
 	for _, i := range installations {
 		owner := i.GetAccount().GetLogin()
 		if owner == "" {
-			return nil, fmt.Errorf("failed to get owner for installation: %d", i.GetID())
+			return nil, nil, fmt.Errorf("failed to get owner for installation: %d", i.GetID())
 		}
 		installs[strings.ToLower(owner)] = i.GetID()
 	}
-
-	return &GithubApp{
-		App:           client.Apps,
-		Installations: installs,
-		Clients:       make(map[string]*GithubClient, len(installations)),
-	}, nil
+	return client, installs, nil
 }
 
-func (a *GithubApp) createInstallationToken(owner string) (token string, expiration time.Time, err error) {
+func (a *GithubApp) createInstallationToken(ctx context.Context, owner string, opts *github.InstallationTokenOptions) (token string, expiration time.Time, err error) {
 	owner = strings.ToLower(owner)
+	a.mu.RLock()
 	id, ok := a.Installations[owner]
+	apps, hasApps := a.apps[owner]
+	a.mu.RUnlock()
 	if !ok {
 		return token, expiration, fmt.Errorf("the deploy key app is not installed for user or org: '%s'", owner)
 	}
-	installationToken, _, err := a.App.CreateInstallationToken(context.TODO(), id, nil)
+	if !hasApps {
+		apps = a.App
+	}
+	installationToken, _, err := apps.CreateInstallationToken(ctx, id, opts)
 	if err != nil {
 		return token, expiration, fmt.Errorf("failed to create token: %s", err)
 	}
@@ -73,20 +241,77 @@ func (a *GithubApp) createInstallationToken(owner string) (token string, expirat
 	return token, expiration, nil
 }
 
-func (a *GithubApp) getInstallationClient(owner string) (client *GithubClient, err error) {
+// List the (lower case) owners the app is installed on.
+func (a *GithubApp) owners() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	owners := make([]string, 0, len(a.Installations))
+	for owner := range a.Installations {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+	return owners
+}
+
+// Revoke an installation token (e.g. one that has been superseded or leaked).
+func (a *GithubApp) revokeInstallationToken(ctx context.Context, owner, token string) error {
+	newClient := a.TokenClient
+	if newClient == nil {
+		newClient = a.newTokenClient
+	}
+	client, err := newClient(strings.ToLower(owner), token)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %s", err)
+	}
+	if res, err := client.RevokeInstallationToken(ctx); err != nil {
+		// The token has already expired or been revoked.
+		if res != nil && res.StatusCode == http.StatusUnauthorized {
+			return nil
+		}
+		return fmt.Errorf("failed to revoke token: %s", err)
+	}
+	return nil
+}
+
+func (a *GithubApp) newTokenClient(owner, token string) (AppsClient, error) {
+	endpoint, _ := a.endpoints.forOwner(owner)
+	client, err := endpoint.newClient(a.newOAuthClient(owner, token))
+	if err != nil {
+		return nil, err
+	}
+	return client.Apps, nil
+}
+
+// Create a HTTP client authenticated with an installation token for the owner.
+func (a *GithubApp) newOAuthClient(owner, token string) *http.Client {
+	return &http.Client{
+		Transport: &oauth2.Transport{
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}),
+			Base:   a.Transport(owner, http.DefaultTransport),
+		},
+	}
+}
+
+func (a *GithubApp) getInstallationClient(ctx context.Context, owner string) (client *GithubClient, err error) {
 	owner = strings.ToLower(owner)
+	a.clientsMu.Lock()
+	defer a.clientsMu.Unlock()
+
 	if c, ok := a.Clients[owner]; !ok || c.isExpired() {
-		token, expiration, err := a.createInstallationToken(owner)
+		token, expiration, err := a.createInstallationToken(ctx, owner, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get installation token: %s", err)
 		}
-		oauth := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: token},
-		))
-		client := github.NewClient(oauth)
+		endpoint, _ := a.endpoints.forOwner(owner)
+		client, err := endpoint.newClient(a.newOAuthClient(owner, token))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create installation client: %s", err)
+		}
 		a.Clients[owner] = &GithubClient{
 			Repos:      client.Repositories,
 			Apps:       client.Apps,
+			Teams:      client.Teams,
 			Expiration: expiration,
 		}
 	}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+)
+
+// AuditFinding describes a problem with a deploy key.
+type AuditFinding string
+
+// Possible audit findings.
+const (
+	// FindingUnmanaged is a key which does not match the title template of any of the audited teams.
+	FindingUnmanaged AuditFinding = "unmanaged"
+
+	// FindingStale is a key which is older than its rotation interval (plus the minimum interval,
+	// which is the schedule of the lambda). Unmanaged keys use the default rotation interval.
+	FindingStale AuditFinding = "stale"
+
+	// FindingDuplicate is a key which has the same title as a newer key on the repository.
+	FindingDuplicate AuditFinding = "duplicate"
+
+	// FindingReadWrite is a key with write access where the config says it should be read-only.
+	FindingReadWrite AuditFinding = "read-write"
+)
+
+// AuditReport lists the deploy keys found on the audited repositories.
+type AuditReport struct {
+	Keys []*AuditKey `json:"keys"`
+}
+
+// AuditKey describes a deploy key on a repository and any problems found with it.
+type AuditKey struct {
+	Team       string         `json:"team,omitempty"`
+	Owner      string         `json:"owner"`
+	Repository string         `json:"repository"`
+	KeyID      int64          `json:"keyId"`
+	Title      string         `json:"title"`
+	ReadOnly   bool           `json:"readOnly"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	Findings   []AuditFinding `json:"findings,omitempty"`
+}
+
+// Audit the deploy keys on every repository the key service is installed on. Keys are matched against the
+// title template of each team configured for the repository to find the team that manages them. It only performs
+// read calls, and repositories which cannot be listed are returned as an aggregated error along with the report.
+func Audit(ctx context.Context, manager *Manager, config Config, teams []Team) (*AuditReport, error) {
+	result := &Result{}
+
+	// Team configs by repository (owner/name)
+	managed := make(map[string][]teamRepository)
+	for _, team := range teams {
+		if hasSelectors(team) {
+			repositories, err := manager.resolveRepositories(ctx, team)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve repository selectors for '%s': %s", team.Name, err)
+			}
+			team.Repositories = repositories
+		}
+		for _, repository := range team.Repositories {
+			id := strings.ToLower(repository.Owner + "/" + repository.Name)
+			managed[id] = append(managed[id], teamRepository{team: team, repository: repository})
+		}
+	}
+
+	var repositories []Repository
+	for _, owner := range manager.keyService.owners() {
+		list, err := manager.listRepositories(ctx, owner)
+		if err != nil {
+			result.Repositories = append(result.Repositories, &RepositoryResult{
+				Owner:  owner,
+				Status: StatusFailed,
+				Reason: fmt.Sprintf("failed to list repositories: %s", err),
+			})
+			continue
+		}
+		for _, r := range list {
+			repositories = append(repositories, Repository{Name: r.GetName(), Owner: owner})
+		}
+	}
+
+	var (
+		keys    = make([][]*AuditKey, len(repositories))
+		results = make([]*RepositoryResult, len(repositories))
+		jobs    = make(chan int)
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < config.workers(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				repository := repositories[j]
+				r := &RepositoryResult{Name: repository.Name, Owner: repository.Owner}
+				results[j] = r
+
+				k, err := auditRepository(ctx, manager, config, repository, managed[strings.ToLower(repository.Owner+"/"+repository.Name)])
+				if err != nil {
+					r.Status, r.Reason = StatusFailed, err.Error()
+					continue
+				}
+				keys[j] = k
+			}
+		}()
+	}
+	for i := range repositories {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	report := &AuditReport{}
+	for i := range repositories {
+		report.Keys = append(report.Keys, keys[i]...)
+	}
+	sort.SliceStable(report.Keys, func(i, j int) bool {
+		a, b := report.Keys[i], report.Keys[j]
+		if a.Owner != b.Owner {
+			return a.Owner < b.Owner
+		}
+		if a.Repository != b.Repository {
+			return a.Repository < b.Repository
+		}
+		return a.KeyID < b.KeyID
+	})
+	result.Repositories = append(result.Repositories, results...)
+	return report, result.Err()
+}
+
+// teamRepository is a repository in the config of a team.
+type teamRepository struct {
+	team       Team
+	repository Repository
+}
+
+func auditRepository(ctx context.Context, manager *Manager, config Config, repository Repository, managed []teamRepository) ([]*AuditKey, error) {
+	titles := make(map[string]teamRepository, len(managed))
+	for _, m := range managed {
+		title, err := NewTemplate(m.team.Name, m.repository.Name, m.repository.Owner, config.KeyTitle).String()
+		if err != nil {
+			return nil, fmt.Errorf("failed to github title template: %s", err)
+		}
+		titles[title] = m
+	}
+	keys, err := manager.listKeys(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list github keys: %s", err)
+	}
+
+	var audited []*AuditKey
+	newest := make(map[string]*AuditKey)
+	for _, key := range keys {
+		k := newAuditKey(repository, key)
+		maxAge := config.rotationInterval()
+
+		m, ok := titles[k.Title]
+		if ok {
+			k.Team = m.team.Name
+			maxAge = m.team.RotationIntervalFor(m.repository, maxAge)
+		} else {
+			k.Findings = append(k.Findings, FindingUnmanaged)
+		}
+		if !k.CreatedAt.IsZero() && time.Since(k.CreatedAt) > maxAge+config.MinRotationInterval {
+			k.Findings = append(k.Findings, FindingStale)
+		}
+		if ok && m.repository.ReadOnly && !k.ReadOnly {
+			k.Findings = append(k.Findings, FindingReadWrite)
+		}
+		if n, ok := newest[k.Title]; !ok || k.KeyID > n.KeyID {
+			newest[k.Title] = k
+		}
+		audited = append(audited, k)
+	}
+
+	// Superseded keys of a team are expected to be deleted once the overlap has passed.
+	for _, k := range audited {
+		n := newest[k.Title]
+		if k == n {
+			continue
+		}
+		if k.Team != "" && time.Since(n.CreatedAt) <= config.KeyOverlap+config.MinRotationInterval {
+			continue
+		}
+		k.Findings = append(k.Findings, FindingDuplicate)
+	}
+	return audited, nil
+}
+
+func newAuditKey(repository Repository, key *github.Key) *AuditKey {
+	return &AuditKey{
+		Owner:      repository.Owner,
+		Repository: repository.Name,
+		KeyID:      key.GetID(),
+		Title:      key.GetTitle(),
+		ReadOnly:   key.GetReadOnly(),
+		CreatedAt:  key.GetCreatedAt().Time,
+	}
+}
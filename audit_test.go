@@ -0,0 +1,78 @@
+package handler_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+func TestAudit(t *testing.T) {
+	title := "concourse-test-team-deploy-key"
+
+	fake := newFakeGithub(100)
+	fake.repositories["telia-oss/test-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("test-repository")}
+	fake.keys["telia-oss/test-repository"] = []*github.Key{
+		{ID: github.Int64(1), Title: github.String(title), ReadOnly: github.Bool(false), CreatedAt: &github.Timestamp{Time: time.Now().AddDate(0, 0, -10)}},
+		{ID: github.Int64(2), Title: github.String(title), ReadOnly: github.Bool(true), CreatedAt: &github.Timestamp{Time: time.Now().AddDate(0, 0, -2)}},
+		{ID: github.Int64(3), Title: github.String("some-other-key"), ReadOnly: github.Bool(true), CreatedAt: &github.Timestamp{Time: time.Now()}},
+	}
+	// Repositories which are not in any team config are audited as well
+	fake.repositories["telia-oss/other-repository"] = &github.Repository{ID: github.Int64(2), Name: github.String("other-repository")}
+	fake.keys["telia-oss/other-repository"] = []*github.Key{
+		{ID: github.Int64(4), Title: github.String("manual-key"), ReadOnly: github.Bool(false), CreatedAt: &github.Timestamp{Time: time.Now().AddDate(0, 0, -1)}},
+		{ID: github.Int64(5), Title: github.String("manual-key"), ReadOnly: github.Bool(true), CreatedAt: &github.Timestamp{Time: time.Now()}},
+		{ID: github.Int64(6), Title: github.String(title), ReadOnly: github.Bool(true), CreatedAt: &github.Timestamp{Time: time.Now().AddDate(0, 0, -30)}},
+	}
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+	}
+	manager := handler.NewTestManager(nil, nil, services, services, nil, nil)
+	config := handler.Config{
+		KeyTitle:            "concourse-{{.Team}}-deploy-key",
+		KeyOverlap:          1 * time.Hour,
+		MinRotationInterval: 30 * time.Minute,
+	}
+
+	team := handler.Team{
+		Name:         "test-team",
+		Repositories: []handler.Repository{{Name: "test-repository", Owner: "telia-oss", ReadOnly: true}},
+	}
+	report, err := handler.Audit(context.Background(), manager, config, []handler.Team{team})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	findings := make(map[int64][]handler.AuditFinding)
+	for _, k := range report.Keys {
+		findings[k.KeyID] = k.Findings
+	}
+	want := map[int64][]handler.AuditFinding{
+		1: {handler.FindingStale, handler.FindingReadWrite, handler.FindingDuplicate},
+		2: nil,
+		3: {handler.FindingUnmanaged},
+		4: {handler.FindingUnmanaged, handler.FindingDuplicate},
+		5: {handler.FindingUnmanaged},
+		6: {handler.FindingUnmanaged, handler.FindingStale},
+	}
+	if !reflect.DeepEqual(findings, want) {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", findings, want)
+	}
+	for _, r := range fake.requests {
+		if !strings.HasPrefix(r, "GET ") {
+			t.Errorf("expected only read requests, got: %s", r)
+		}
+	}
+}
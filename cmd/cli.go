@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+// cliCommand is a subcommand used to run the handler outside of Lambda.
+type cliCommand interface {
+	run(ctx context.Context, manager *handler.Manager, config handler.Config) (interface{}, error)
+}
+
+// RotateCommand options
+type RotateCommand struct {
+	TeamConfig []string `long:"team-config" required:"true" description:"Path to a JSON file with the team config (same as the Lambda payload). Can be repeated."`
+}
+
+func (c *RotateCommand) run(ctx context.Context, manager *handler.Manager, config handler.Config) (interface{}, error) {
+	return handleTeams(ctx, manager, config, c.TeamConfig)
+}
+
+// PlanCommand options
+type PlanCommand struct {
+	TeamConfig []string `long:"team-config" required:"true" description:"Path to a JSON file with the team config (same as the Lambda payload). Can be repeated."`
+}
+
+func (c *PlanCommand) run(ctx context.Context, manager *handler.Manager, config handler.Config) (interface{}, error) {
+	config.DryRun = true
+	return handleTeams(ctx, manager, config, c.TeamConfig)
+}
+
+// ForceCommand options
+type ForceCommand struct {
+	TeamConfig string   `long:"team-config" required:"true" description:"Path to a JSON file with the team config (same as the Lambda payload)."`
+	Repository []string `long:"repository" description:"Only force the rotation for a repository (owner/name). Can be repeated. Defaults to all of the team's repositories."`
+}
+
+func (c *ForceCommand) run(ctx context.Context, manager *handler.Manager, config handler.Config) (interface{}, error) {
+	teams, err := readTeams([]string{c.TeamConfig})
+	if err != nil {
+		return nil, err
+	}
+	team := teams[0]
+	if len(c.Repository) == 0 {
+		team.Force = true
+	}
+	for _, name := range c.Repository {
+		found := false
+		for i, r := range team.Repositories {
+			if strings.EqualFold(r.Owner+"/"+r.Name, name) {
+				team.Repositories[i].Force, found = true, true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("repository is not in the team config: %s", name)
+		}
+	}
+	result, err := handler.New(manager, config, logger)(ctx, team)
+	if result == nil {
+		return nil, err
+	}
+	return result, err
+}
+
+// AuditCommand options
+type AuditCommand struct {
+	TeamConfig []string `long:"team-config" description:"Path to a JSON file with the team config (same as the Lambda payload), used to tell which keys are managed. Can be repeated."`
+}
+
+func (c *AuditCommand) run(ctx context.Context, manager *handler.Manager, config handler.Config) (interface{}, error) {
+	teams, err := readTeams(c.TeamConfig)
+	if err != nil {
+		return nil, err
+	}
+	return handler.Audit(ctx, manager, config, teams)
+}
+
+// RevokeCommand options
+type RevokeCommand struct {
+	Team       string `long:"team" description:"Name of the team to revoke. Defaults to the team in --team-config."`
+	Owner      string `long:"owner" description:"Owner to revoke. Revokes all teams on the owner unless --team or --team-config is set."`
+	TeamConfig string `long:"team-config" description:"Path to a JSON file with the team config, to also revoke repositories without labelled secrets."`
+}
+
+func (c *RevokeCommand) run(ctx context.Context, manager *handler.Manager, config handler.Config) (interface{}, error) {
+	if c.Team == "" && c.Owner == "" && c.TeamConfig == "" {
+		return nil, errors.New("either --team, --team-config or --owner is required")
+	}
+	team := handler.Team{Name: c.Team}
+	if c.TeamConfig != "" {
+		teams, err := readTeams([]string{c.TeamConfig})
+		if err != nil {
+			return nil, err
+		}
+		if c.Team != "" && teams[0].Name != c.Team {
+			return nil, fmt.Errorf("team config is for %q, not %q", teams[0].Name, c.Team)
+		}
+		team = teams[0]
+	}
+	result, err := manager.Revoke(ctx, config, team, c.Owner)
+	if result == nil {
+		return nil, err
+	}
+	return result, err
+}
+
+// Register the CLI subcommands with the parser.
+func addCommands(parser *flags.Parser) map[string]cliCommand {
+	commands := map[string]cliCommand{
+		"rotate": &RotateCommand{},
+		"plan":   &PlanCommand{},
+		"force":  &ForceCommand{},
+		"audit":  &AuditCommand{},
+		"revoke": &RevokeCommand{},
+	}
+	descriptions := map[string]string{
+		"rotate": "Create or rotate access tokens and deploy keys for teams.",
+		"plan":   "Show what rotate would do without making any changes.",
+		"force":  "Rotate deploy keys and access tokens right away, deleting the old keys and revoking the old tokens.",
+		"audit":  "Report unmanaged, duplicate, stale and read-write deploy keys on all repositories the key service is installed on.",
+		"revoke": "Delete the deploy keys, revoke the access tokens and delete the secrets for a team or an owner.",
+	}
+	for _, name := range []string{"rotate", "plan", "force", "audit", "revoke"} {
+		if _, err := parser.AddCommand(name, descriptions[name], descriptions[name], commands[name]); err != nil {
+			logger.Fatalf("failed to add command: %s", err)
+		}
+	}
+	return commands
+}
+
+// Run a subcommand and write the output to stdout. Returns the exit code.
+func runCommand(command cliCommand, manager *handler.Manager, config handler.Config, format string) int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	output, err := command.run(ctx, manager, config)
+	if output != nil {
+		if err := writeOutput(os.Stdout, format, output); err != nil {
+			logger.Errorf("failed to write output: %s", err)
+			return 1
+		}
+	}
+	if err != nil {
+		logger.Error(err)
+		return 1
+	}
+	return 0
+}
+
+// Run the handler for each of the team configs.
+func handleTeams(ctx context.Context, manager *handler.Manager, config handler.Config, paths []string) ([]*handler.Result, error) {
+	teams, err := readTeams(paths)
+	if err != nil {
+		return nil, err
+	}
+	f := handler.New(manager, config, logger)
+
+	var (
+		results []*handler.Result
+		errs    []string
+	)
+	for _, team := range teams {
+		result, err := f(ctx, team)
+		if result != nil {
+			results = append(results, result)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", team.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("failed to process %d of %d teams: %s", len(errs), len(teams), strings.Join(errs, "; "))
+	}
+	return results, nil
+}
+
+// Read team configs from JSON files.
+func readTeams(paths []string) ([]handler.Team, error) {
+	var teams []handler.Team
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read team config: %s", err)
+		}
+		var team handler.Team
+		if err := json.Unmarshal(b, &team); err != nil {
+			return nil, fmt.Errorf("failed to parse team config: %s: %s", path, err)
+		}
+		teams = append(teams, team)
+	}
+	return teams, nil
+}
+
+// Write the output as JSON, CSV (audit only) or human readable text.
+func writeOutput(out io.Writer, format string, output interface{}) error {
+	switch format {
+	case "json":
+		b, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(b))
+		return err
+	case "csv":
+		report, ok := output.(*handler.AuditReport)
+		if !ok {
+			return errors.New("csv output is only supported for audit")
+		}
+		return writeAuditCSV(out, report)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	switch o := output.(type) {
+	case []*handler.Result:
+		for i, result := range o {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			writeResult(w, result)
+		}
+	case *handler.Result:
+		writeResult(w, o)
+	case *handler.AuditReport:
+		fmt.Fprintln(w, "TEAM\tOWNER\tREPOSITORY\tKEY\tTITLE\tREAD ONLY\tCREATED\tFINDINGS")
+		for _, k := range o.Keys {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+				k.Team, k.Owner, k.Repository, k.KeyID, k.Title, strconv.FormatBool(k.ReadOnly),
+				k.CreatedAt.Format("2006-01-02"), joinFindings(k.Findings, ","),
+			)
+		}
+	default:
+		return fmt.Errorf("unsupported output: %T", output)
+	}
+	return w.Flush()
+}
+
+func writeAuditCSV(out io.Writer, report *handler.AuditReport) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"team", "owner", "repository", "key_id", "title", "read_only", "created_at", "findings"}); err != nil {
+		return err
+	}
+	for _, k := range report.Keys {
+		if err := w.Write([]string{
+			k.Team, k.Owner, k.Repository, strconv.FormatInt(k.KeyID, 10), k.Title, strconv.FormatBool(k.ReadOnly),
+			k.CreatedAt.Format(time.RFC3339), joinFindings(k.Findings, ";"),
+		}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func joinFindings(findings []handler.AuditFinding, sep string) string {
+	s := make([]string, len(findings))
+	for i, f := range findings {
+		s[i] = string(f)
+	}
+	return strings.Join(s, sep)
+}
+
+func writeResult(w io.Writer, result *handler.Result) {
+	header := "Team: " + result.Team
+	if result.Team == "" {
+		header = "All teams"
+	}
+	if result.DryRun {
+		header += " (plan)"
+	}
+	fmt.Fprintln(w, header)
+	fmt.Fprintln(w, "TEAM\tOWNER\tREPOSITORY\tSTATUS\tDETAILS")
+
+	counts := make(map[handler.ActionType]int)
+	for _, repositories := range [][]*handler.RepositoryResult{result.Repositories, result.Removed} {
+		for _, r := range repositories {
+			team := r.Team
+			if team == "" {
+				team = result.Team
+			}
+			details := r.Reason
+			if details == "" {
+				actions := make([]string, len(r.Actions))
+				for i, a := range r.Actions {
+					actions[i] = fmt.Sprintf("%s %s", a.Type, a.Target)
+					counts[a.Type]++
+				}
+				details = strings.Join(actions, ", ")
+				if r.RenamedTo != "" {
+					details = strings.TrimSuffix("renamed to "+r.RenamedTo+": "+details, ": ")
+				}
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", team, r.Owner, r.Name, r.Status, details)
+		}
+	}
+
+	var summary []string
+	for _, a := range []struct {
+		action handler.ActionType
+		label  string
+	}{
+		{handler.ActionCreateKey, "deploy keys created"},
+		{handler.ActionDeleteKey, "deploy keys deleted"},
+		{handler.ActionWriteSecret, "secrets written"},
+		{handler.ActionDeleteSecret, "secrets deleted"},
+		{handler.ActionRevokeToken, "access tokens revoked"},
+	} {
+		if n := counts[a.action]; n > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", n, a.label))
+		}
+	}
+	if len(summary) == 0 {
+		summary = append(summary, "no changes")
+	}
+	fmt.Fprintln(w, "Summary: "+strings.Join(summary, ", "))
+
+	for _, l := range result.RateLimits {
+		fmt.Fprintf(w, "Rate limit: %d of %d requests remaining for %s on %s (resets %s)\n",
+			l.Remaining, l.Limit, l.App, l.Owner, l.Reset.Local().Format("15:04:05"))
+	}
+}
@@ -1,6 +1,13 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/jessevdk/go-flags"
@@ -11,13 +18,47 @@ import (
 
 // Command options
 type Command struct {
-	TokenPath                 string `long:"token-path" env:"SECRETS_MANAGER_TOKEN_PATH" default:"/concourse/{{.Team}}/{{.Owner}}-access-token" description:"Path to use when writing access tokens to AWS Secrets manager."`
-	KeyPath                   string `long:"key-path" env:"SECRETS_MANAGER_KEY_PATH" default:"/concourse/{{.Team}}/{{.Repository}}-deploy-key" description:"Path to use when writing private keys to AWS Secrets manager."`
-	KeyTitle                  string `long:"key-title" env:"GITHUB_KEY_TITLE" default:"concourse-{{.Team}}-deploy-key" description:"Title to use when adding deploy keys to Github."`
-	TokenServiceIntegrationID int64  `long:"token-service-integration-id" env:"GITHUB_TOKEN_SERVICE_INTEGRATION_ID" description:"Integration ID for the access token Github App." required:"true"`
-	TokenServicePrivateKey    string `long:"token-service-private-key" env:"GITHUB_TOKEN_SERVICE_PRIVATE_KEY" description:"Private key for the access token Github App." required:"true"`
-	KeyServiceIntegrationID   int64  `long:"key-service-integration-id" env:"GITHUB_KEY_SERVICE_INTEGRATION_ID" description:"Integration ID for the deploy key Github App." required:"true"`
-	KeyServicePrivateKey      string `long:"key-service-private-key" env:"GITHUB_KEY_SERVICE_PRIVATE_KEY" description:"Private key for the deploy key Github App." required:"true"`
+	TokenPath                 string        `long:"token-path" env:"SECRETS_MANAGER_TOKEN_PATH" default:"/concourse/{{.Team}}/{{.Owner}}-access-token" description:"Path to use when writing access tokens to the secret store."`
+	KeyPath                   string        `long:"key-path" env:"SECRETS_MANAGER_KEY_PATH" default:"/concourse/{{.Team}}/{{.Repository}}-deploy-key" description:"Path to use when writing private keys to the secret store."`
+	KeyTitle                  string        `long:"key-title" env:"GITHUB_KEY_TITLE" default:"concourse-{{.Team}}-deploy-key" description:"Title to use when adding deploy keys to Github."`
+	TokenServiceIntegrationID int64         `long:"token-service-integration-id" env:"GITHUB_TOKEN_SERVICE_INTEGRATION_ID" description:"Integration ID for the access token Github App." required:"true"`
+	TokenServicePrivateKey    string        `long:"token-service-private-key" env:"GITHUB_TOKEN_SERVICE_PRIVATE_KEY" description:"Private key for the access token Github App." required:"true"`
+	KeyServiceIntegrationID   int64         `long:"key-service-integration-id" env:"GITHUB_KEY_SERVICE_INTEGRATION_ID" description:"Integration ID for the deploy key Github App." required:"true"`
+	KeyServicePrivateKey      string        `long:"key-service-private-key" env:"GITHUB_KEY_SERVICE_PRIVATE_KEY" description:"Private key for the deploy key Github App." required:"true"`
+	GithubBaseURL             string        `long:"github-base-url" env:"GITHUB_BASE_URL" description:"Base URL of the Github API when using Github Enterprise Server (e.g. https://github.example.com/api/v3/)."`
+	GithubUploadURL           string        `long:"github-upload-url" env:"GITHUB_UPLOAD_URL" description:"Upload URL for Github Enterprise Server. Defaults to the base URL."`
+	GithubOwnerEndpoints      string        `long:"github-owner-endpoints" env:"GITHUB_OWNER_ENDPOINTS" description:"JSON object mapping owners to the baseUrl (and uploadUrl) of the Github instance they are on, the credentials (tokenService and keyService) of the Github Apps on that instance, and the sshHost (and sshHostKeys) used to verify deploy keys."`
+	GithubRetries             int           `long:"github-retries" env:"GITHUB_RETRIES" default:"3" description:"Number of times requests to Github are retried when they hit a rate limit or fail with a server error."`
+	GithubMaxRetryWait        time.Duration `long:"github-max-retry-wait" env:"GITHUB_MAX_RETRY_WAIT" default:"30s" description:"Longest to wait before retrying a request to Github. Requests are not retried when the rate limit resets later than this."`
+	GithubMinRemaining        int           `long:"github-min-remaining" env:"GITHUB_MIN_REMAINING" default:"100" description:"Do not start on new repositories for an owner when less than this many requests remain for the installation."`
+	KeyGenerator              string        `long:"key-generator" env:"KEY_GENERATOR" default:"local" choice:"local" choice:"ec2" description:"Backend used to generate deploy key pairs."`
+	SecretStore               string        `long:"secret-store" env:"SECRET_STORE" default:"secretsmanager" choice:"secretsmanager" choice:"ssm" choice:"vault" description:"Backend used to store access tokens and private keys."`
+	StateStore                string        `long:"state-store" env:"STATE_STORE" default:"none" choice:"none" choice:"dynamodb" description:"Backend used to record the rotation state of deploy keys."`
+	StateTable                string        `long:"state-table" env:"STATE_TABLE" description:"Name of the DynamoDB table when using the dynamodb state store."`
+	VaultAddress              string        `long:"vault-address" env:"VAULT_ADDR" description:"Address of the Vault server when using the vault secret store."`
+	VaultMount                string        `long:"vault-mount" env:"VAULT_MOUNT" description:"Mount of the KV v2 secrets engine. Defaults to the first segment of the secret path."`
+	VaultAuthMethod           string        `long:"vault-auth-method" env:"VAULT_AUTH_METHOD" default:"token" choice:"token" choice:"aws" description:"Method used to authenticate with Vault."`
+	VaultToken                string        `long:"vault-token" env:"VAULT_TOKEN" description:"Token used for the token auth method."`
+	VaultAWSMount             string        `long:"vault-aws-mount" env:"VAULT_AWS_MOUNT" default:"aws" description:"Mount of the AWS auth method."`
+	VaultAWSRole              string        `long:"vault-aws-role" env:"VAULT_AWS_ROLE" description:"Vault role used for the aws auth method."`
+	VaultAWSServerID          string        `long:"vault-aws-server-id" env:"VAULT_AWS_SERVER_ID" description:"Value for the X-Vault-AWS-IAM-Server-ID header used for the aws auth method."`
+	Lenient                   bool          `long:"lenient" env:"LENIENT" description:"Do not return an error when repositories fail (failures are still reported in the result)."`
+	DryRun                    bool          `long:"dry-run" env:"DRY_RUN" description:"Only plan the changes without writing to Github or the secret store."`
+	RotationInterval          time.Duration `long:"rotation-interval" env:"ROTATION_INTERVAL" default:"168h" description:"Default interval for rotating deploy keys. Can be overridden per team and repository."`
+	ScheduleInterval          time.Duration `long:"schedule-interval" env:"SCHEDULE_INTERVAL" default:"30m" description:"How often the lambda is invoked. Rotation intervals shorter than this are rejected."`
+	MaxRotationInterval       time.Duration `long:"max-rotation-interval" env:"MAX_ROTATION_INTERVAL" default:"2160h" description:"Longest allowed rotation interval."`
+	Workers                   int           `long:"workers" env:"WORKERS" default:"10" description:"Number of repositories to process concurrently."`
+	Reconcile                 bool          `long:"reconcile" env:"RECONCILE" description:"Delete deploy keys and secrets for repositories that have been removed from a team's config."`
+	RemovalDelay              time.Duration `long:"removal-delay" env:"REMOVAL_DELAY" default:"24h" description:"How long to wait after a repository has been removed before deleting its deploy key and secrets."`
+	TokenGracePeriod          time.Duration `long:"token-grace-period" env:"TOKEN_GRACE_PERIOD" default:"10s" description:"How long superseded access tokens remain valid before they are revoked."`
+	DeadlineMargin            time.Duration `long:"deadline-margin" env:"DEADLINE_MARGIN" default:"30s" description:"Do not start on new repositories when less than this remains before the lambda times out."`
+	VerifyKeys                bool          `long:"verify-keys" env:"VERIFY_KEYS" description:"Verify that new deploy keys can access their repository over SSH before the old keys are replaced."`
+	VerifySSHHost             string        `long:"verify-ssh-host" env:"VERIFY_SSH_HOST" description:"Address of the git host used to verify new deploy keys. Defaults to the host of --github-base-url (or github.com) on port 22."`
+	VerifySSHHostKeys         string        `long:"verify-ssh-host-keys" env:"VERIFY_SSH_HOST_KEYS" description:"Public keys of the git host in authorized_keys format, separated by newlines or commas."`
+	SecretRetries             int           `long:"secret-retries" env:"SECRET_RETRIES" default:"3" description:"Number of times writes to the secret store are retried when they fail with a transient error."`
+	SecretRetryBackoff        time.Duration `long:"secret-retry-backoff" env:"SECRET_RETRY_BACKOFF" default:"1s" description:"Wait before the first retry of a write to the secret store, which doubles with each retry."`
+	KeyOverlap                time.Duration `long:"key-overlap" env:"KEY_OVERLAP" default:"1h" description:"How long old deploy keys are kept on Github after being rotated."`
+	Output                    string        `long:"output" short:"o" env:"OUTPUT" default:"text" choice:"text" choice:"json" choice:"csv" description:"Output format when running a CLI command (csv is only supported for audit)."`
 }
 
 var logger *logrus.Logger
@@ -43,26 +84,174 @@ func main() {
 		logger.Fatalf("failed to populate environment: %s", err)
 	}
 
-	// Parse environment variables
+	// Parse environment variables (and the CLI subcommand, if any)
 	var command Command
-	_, err = flags.Parse(&command)
-	if err != nil {
+	parser := flags.NewParser(&command, flags.Default)
+	parser.SubcommandsOptional = true
+	commands := addCommands(parser)
+
+	if _, err := parser.Parse(); err != nil {
+		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
 		logger.Fatalf("failed to parse flag: %s", err)
 	}
 
+	// Without a subcommand we expect to be running in Lambda
+	if parser.Active == nil && !inLambda() {
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	}
+	if parser.Active != nil {
+		logger.Formatter = &logrus.TextFormatter{}
+	}
+
+	manager, config, err := setup(sess, command)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	if parser.Active != nil {
+		os.Exit(runCommand(commands[parser.Active.Name], manager, config, command.Output))
+	}
+
+	// Run
+	f := handler.New(manager, config, logger)
+	lambda.Start(f)
+}
+
+// Returns true when running in the Lambda runtime.
+func inLambda() bool {
+	return os.Getenv("AWS_LAMBDA_RUNTIME_API") != "" || os.Getenv("_LAMBDA_SERVER_PORT") != ""
+}
+
+// Create the manager and handler config from the command options.
+func setup(sess *session.Session, command Command) (*handler.Manager, handler.Config, error) {
+	var config handler.Config
+
+	// Select the key generator
+	var keyGenerator handler.KeyGenerator
+	switch command.KeyGenerator {
+	case "ec2":
+		keyGenerator = handler.NewEC2KeyGenerator(sess)
+	default:
+		keyGenerator = handler.NewLocalKeyGenerator()
+	}
+
+	// Select the secret store
+	var (
+		secretStore handler.SecretStore
+		err         error
+	)
+	switch command.SecretStore {
+	case "ssm":
+		secretStore = handler.NewSSMStore(sess)
+	case "vault":
+		secretStore, err = handler.NewVaultStore(sess, handler.VaultConfig{
+			Address:     command.VaultAddress,
+			Mount:       command.VaultMount,
+			AuthMethod:  command.VaultAuthMethod,
+			Token:       command.VaultToken,
+			AWSMount:    command.VaultAWSMount,
+			AWSRole:     command.VaultAWSRole,
+			AWSServerID: command.VaultAWSServerID,
+		})
+		if err != nil {
+			return nil, config, fmt.Errorf("failed to create vault secret store: %s", err)
+		}
+	default:
+		secretStore = handler.NewSecretsManagerStore(sess)
+	}
+
+	// Select the state store
+	var stateStore handler.StateStore
+	switch command.StateStore {
+	case "dynamodb":
+		if command.StateTable == "" {
+			return nil, config, errors.New("missing table name for the dynamodb state store")
+		}
+		stateStore = handler.NewDynamoDBStateStore(sess, command.StateTable)
+	}
+
+	// Github instances for each owner
+	endpoints := handler.GithubEndpoints{
+		Default: handler.GithubEndpoint{BaseURL: command.GithubBaseURL, UploadURL: command.GithubUploadURL},
+	}
+	if command.GithubOwnerEndpoints != "" {
+		if err := json.Unmarshal([]byte(command.GithubOwnerEndpoints), &endpoints.Owners); err != nil {
+			return nil, config, fmt.Errorf("failed to parse github owner endpoints: %s", err)
+		}
+	}
+
+	// Verify new keys over SSH, against the git host of the owner's Github instance
+	var keyVerifier handler.KeyVerifier
+	if command.VerifyKeys {
+		owners := make(map[string]handler.SSHHost, len(endpoints.Owners))
+		for owner, e := range endpoints.Owners {
+			// Owners are only verified when the git host of their instance is configured
+			if e.SSHHost != "" || len(e.SSHHostKeys) > 0 {
+				owners[owner] = handler.SSHHost{Host: e.SSHAddress(), HostKeys: e.SSHHostKeys}
+			} else {
+				owners[owner] = handler.SSHHost{}
+			}
+		}
+		host := command.VerifySSHHost
+		if host == "" {
+			host = endpoints.Default.SSHAddress()
+		}
+		keyVerifier, err = handler.NewSSHKeyVerifier(handler.SSHVerifierConfig{
+			Host: host,
+			HostKeys: strings.FieldsFunc(command.VerifySSHHostKeys, func(r rune) bool {
+				return r == '\n' || r == ','
+			}),
+			Owners: owners,
+		})
+		if err != nil {
+			return nil, config, fmt.Errorf("failed to create key verifier: %s", err)
+		}
+	}
+
 	// Create new manager
 	manager, err := handler.NewManager(
-		sess,
 		command.TokenServiceIntegrationID,
 		command.TokenServicePrivateKey,
 		command.KeyServiceIntegrationID,
 		command.KeyServicePrivateKey,
+		endpoints,
+		handler.RateLimitConfig{
+			Retries:      command.GithubRetries,
+			MaxWait:      command.GithubMaxRetryWait,
+			MinRemaining: command.GithubMinRemaining,
+		},
+		keyGenerator,
+		secretStore,
+		stateStore,
+		keyVerifier,
 	)
 	if err != nil {
-		logger.Fatalf("failed to create new manager: %s", err)
+		return nil, config, fmt.Errorf("failed to create new manager: %s", err)
 	}
 
-	// Run
-	f := handler.New(manager, command.TokenPath, command.KeyPath, command.KeyTitle, logger)
-	lambda.Start(f)
+	config = handler.Config{
+		TokenPath:           command.TokenPath,
+		KeyPath:             command.KeyPath,
+		KeyTitle:            command.KeyTitle,
+		Lenient:             command.Lenient,
+		DryRun:              command.DryRun,
+		RotationInterval:    command.RotationInterval,
+		MinRotationInterval: command.ScheduleInterval,
+		MaxRotationInterval: command.MaxRotationInterval,
+		KeyOverlap:          command.KeyOverlap,
+		Workers:             command.Workers,
+		DeadlineMargin:      command.DeadlineMargin,
+		TokenGracePeriod:    command.TokenGracePeriod,
+		Reconcile:           command.Reconcile,
+		RemovalDelay:        command.RemovalDelay,
+		Retries:             command.SecretRetries,
+		RetryBackoff:        command.SecretRetryBackoff,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, config, fmt.Errorf("invalid configuration: %s", err)
+	}
+	return manager, config, nil
 }
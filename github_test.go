@@ -0,0 +1,488 @@
+package handler_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+	"github.com/telia-oss/concourse-github-lambda/mocks"
+)
+
+// fakeGithub is an in-process stand-in for the parts of the Github API used by the handler.
+// List endpoints return at most pageSize results per page.
+type fakeGithub struct {
+	mu            sync.Mutex
+	pageSize      int
+	nextID        int64
+	installations []*github.Installation
+	repositories  map[string]*github.Repository
+	keys          map[string][]*github.Key
+	teams         map[string][]*github.Repository
+	requests      []string
+
+	// apps are the public keys of the Github Apps by integration ID. If set, requests as an
+	// app must be authenticated with a JWT signed by one of them.
+	apps map[int64]*rsa.PublicKey
+}
+
+func newFakeGithub(pageSize int) *fakeGithub {
+	return &fakeGithub{
+		pageSize:     pageSize,
+		nextID:       1000,
+		repositories: make(map[string]*github.Repository),
+		keys:         make(map[string][]*github.Key),
+		teams:        make(map[string][]*github.Repository),
+	}
+}
+
+// Start the fake server and return a client configured to use it.
+func (g *fakeGithub) Start(t *testing.T) (*github.Client, func()) {
+	t.Helper()
+	server := httptest.NewServer(g)
+	client := github.NewClient(server.Client())
+	u, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+	client.BaseURL, client.UploadURL = u, u
+	return client, server.Close
+}
+
+func (g *fakeGithub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Github Enterprise Server serves the API below /api/v3
+	p := strings.TrimPrefix(r.URL.Path, "/api/v3")
+
+	g.requests = append(g.requests, r.Method+" "+p+"?"+r.URL.RawQuery)
+	parts := strings.Split(strings.Trim(p, "/"), "/")
+
+	if g.apps != nil && parts[0] == "app" && !g.authenticateApp(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && p == "/app/installations":
+		g.writePage(w, r, g.installations)
+
+	case r.Method == http.MethodPost && len(parts) == 4 && parts[0] == "app" && parts[3] == "access_tokens":
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(&github.InstallationToken{
+			Token:     github.String("token"),
+			ExpiresAt: aws.Time(time.Now().Add(time.Hour)),
+		})
+
+	case r.Method == http.MethodDelete && p == "/installation/token":
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodGet && p == "/installation/repositories":
+		var repositories []*github.Repository
+		for _, repository := range g.repositories {
+			repositories = append(repositories, repository)
+		}
+		sort.Slice(repositories, func(i, j int) bool { return repositories[i].GetID() < repositories[j].GetID() })
+		start, end, ok := g.page(w, r, len(repositories))
+		if !ok {
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"total_count":  len(repositories),
+			"repositories": repositories[start:end],
+		})
+
+	case len(parts) == 5 && parts[0] == "orgs" && parts[2] == "teams" && parts[4] == "repos" && r.Method == http.MethodGet:
+		repositories, ok := g.teams[parts[1]+"/"+parts[3]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		g.writePage(w, r, repositories)
+
+	case len(parts) == 3 && parts[0] == "repos" && r.Method == http.MethodGet:
+		repository, ok := g.repositories[parts[1]+"/"+parts[2]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(repository)
+
+	case len(parts) == 4 && parts[0] == "repos" && parts[3] == "keys" && r.Method == http.MethodGet:
+		g.writePage(w, r, g.keys[parts[1]+"/"+parts[2]])
+
+	case len(parts) == 4 && parts[0] == "repos" && parts[3] == "keys" && r.Method == http.MethodPost:
+		var key github.Key
+		json.NewDecoder(r.Body).Decode(&key)
+		g.nextID++
+		key.ID = github.Int64(g.nextID)
+		key.CreatedAt = &github.Timestamp{Time: time.Now()}
+		g.keys[parts[1]+"/"+parts[2]] = append(g.keys[parts[1]+"/"+parts[2]], &key)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(&key)
+
+	case len(parts) == 5 && parts[0] == "repos" && parts[3] == "keys" && r.Method == http.MethodDelete:
+		id, _ := strconv.ParseInt(parts[4], 10, 64)
+		repository := parts[1] + "/" + parts[2]
+		for i, key := range g.keys[repository] {
+			if key.GetID() == id {
+				g.keys[repository] = append(g.keys[repository][:i], g.keys[repository][i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// Returns true if the request has a JWT signed by the key of the app it was issued for.
+func (g *fakeGithub) authenticateApp(r *http.Request) bool {
+	token := strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), ".")
+	if len(token) != 3 {
+		return false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(token[1])
+	if err != nil {
+		return false
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return false
+	}
+	id, _ := strconv.ParseInt(claims.Issuer, 10, 64)
+	key, ok := g.apps[id]
+	if !ok {
+		return false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(token[2])
+	if err != nil {
+		return false
+	}
+	digest := sha256.Sum256([]byte(token[0] + "." + token[1]))
+	return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+}
+
+// Write a page of a slice and set the link header for the next page.
+func (g *fakeGithub) writePage(w http.ResponseWriter, r *http.Request, items interface{}) {
+	v := reflect.ValueOf(items)
+	start, end, ok := g.page(w, r, v.Len())
+	if !ok {
+		return
+	}
+	json.NewEncoder(w).Encode(v.Slice(start, end).Interface())
+}
+
+// Determine the bounds of the requested page and set the link header.
+func (g *fakeGithub) page(w http.ResponseWriter, r *http.Request, total int) (start, end int, ok bool) {
+	size := g.pageSize
+	if n, err := strconv.Atoi(r.URL.Query().Get("per_page")); err == nil && n < size {
+		size = n
+	}
+	page := 1
+	if n, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil {
+		page = n
+	}
+
+	start = (page - 1) * size
+	if start > total {
+		w.WriteHeader(http.StatusNotFound)
+		return 0, 0, false
+	}
+	end = start + size
+	if end > total {
+		end = total
+	}
+	if end < total {
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=%d&per_page=%d>; rel="next"`, r.URL.Path, page+1, size))
+	}
+	return start, end, true
+}
+
+func TestHandlerPaginatesKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	title := "concourse-test-team-deploy-key"
+
+	fake := newFakeGithub(2)
+	fake.repositories["telia-oss/test-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("test-repository")}
+	for i := 1; i <= 5; i++ {
+		fake.keys["telia-oss/test-repository"] = append(fake.keys["telia-oss/test-repository"], &github.Key{
+			ID:       github.Int64(int64(i)),
+			Title:    github.String(fmt.Sprintf("some-other-key-%d", i)),
+			ReadOnly: github.Bool(true),
+		})
+	}
+	// The team's key is on the last page
+	fake.keys["telia-oss/test-repository"] = append(fake.keys["telia-oss/test-repository"], &github.Key{
+		ID:       github.Int64(6),
+		Title:    github.String(title),
+		ReadOnly: github.Bool(true),
+	})
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	secrets := mocks.NewMockSecretsClient(ctrl)
+	expectNoPreviousToken(secrets)
+	secrets.EXPECT().DescribeSecretWithContext(gomock.Any(), gomock.Any()).Times(1).Return(&secretsmanager.DescribeSecretOutput{
+		Description: aws.String(fmt.Sprintf("Github credentials for Concourse. Last updated: %s", time.Now().UTC().Format(time.RFC3339))),
+	}, nil)
+	secrets.EXPECT().CreateSecretWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+	secrets.EXPECT().UpdateSecretWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+	}
+	manager := handler.NewTestManager(handler.NewTestSecretsManagerStore(secrets), handler.NewLocalKeyGenerator(), services, services, nil, nil)
+	logger, _ := logrus.NewNullLogger()
+	handle := handler.New(manager, handler.Config{
+		TokenPath: "/concourse/{{.Team}}/{{.Owner}}",
+		KeyPath:   "/concourse/{{.Team}}/{{.Repository}}",
+		KeyTitle:  "concourse-{{.Team}}-deploy-key",
+	}, logger)
+
+	result, err := handle(context.Background(), handler.Team{
+		Name:         "test-team",
+		Repositories: []handler.Repository{{Name: "test-repository", Owner: "telia-oss", ReadOnly: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := result.Repositories[0].Status, handler.StatusSkippedFresh; got != want {
+		t.Errorf("got status %s, want %s", got, want)
+	}
+	if got, want := len(fake.keys["telia-oss/test-repository"]), 6; got != want {
+		t.Errorf("got %d keys, want %d", got, want)
+	}
+
+	var pages int
+	for _, r := range fake.requests {
+		if strings.HasPrefix(r, "GET /repos/telia-oss/test-repository/keys") {
+			pages++
+		}
+	}
+	if got, want := pages, 3; got != want {
+		t.Errorf("got %d requests for keys, want %d", got, want)
+	}
+}
+
+func TestManagerGithubEnterprise(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The Github Apps on each instance have their own integration ID and private key
+	appKeys := make(map[int64]*rsa.PrivateKey)
+	appKey := func(id int64) string {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("failed to generate key: %s", err)
+		}
+		appKeys[id] = key
+		return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	}
+	cloudTokenService, cloudKeyService := appKey(1), appKey(2)
+	enterpriseTokenService, enterpriseKeyService := appKey(11), appKey(12)
+
+	cloud := newFakeGithub(1)
+	cloud.apps = map[int64]*rsa.PublicKey{1: &appKeys[1].PublicKey, 2: &appKeys[2].PublicKey}
+	cloud.installations = []*github.Installation{
+		{ID: github.Int64(1), Account: &github.User{Login: github.String("telia-oss")}},
+		{ID: github.Int64(2), Account: &github.User{Login: github.String("other-org")}},
+	}
+	cloud.repositories["telia-oss/cloud-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("cloud-repository")}
+
+	enterprise := newFakeGithub(1)
+	enterprise.apps = map[int64]*rsa.PublicKey{11: &appKeys[11].PublicKey, 12: &appKeys[12].PublicKey}
+	enterprise.installations = []*github.Installation{
+		{ID: github.Int64(7), Account: &github.User{Login: github.String("business-unit")}},
+	}
+	enterprise.repositories["business-unit/enterprise-repository"] = &github.Repository{ID: github.Int64(2), Name: github.String("enterprise-repository")}
+
+	cloudServer := httptest.NewServer(cloud)
+	defer cloudServer.Close()
+	enterpriseServer := httptest.NewServer(enterprise)
+	defer enterpriseServer.Close()
+
+	secrets := mocks.NewMockSecretsClient(ctrl)
+	expectNoPreviousToken(secrets)
+	secrets.EXPECT().CreateSecretWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+	secrets.EXPECT().UpdateSecretWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+
+	manager, err := handler.NewManager(1, cloudTokenService, 2, cloudKeyService, handler.GithubEndpoints{
+		Default: handler.GithubEndpoint{BaseURL: cloudServer.URL},
+		Owners: map[string]handler.GithubEndpoint{
+			"Business-Unit": {
+				BaseURL:      enterpriseServer.URL,
+				TokenService: handler.GithubAppCredentials{IntegrationID: 11, PrivateKey: enterpriseTokenService},
+				KeyService:   handler.GithubAppCredentials{IntegrationID: 12, PrivateKey: enterpriseKeyService},
+			},
+		},
+	}, handler.RateLimitConfig{}, handler.NewLocalKeyGenerator(), handler.NewTestSecretsManagerStore(secrets), nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create manager: %s", err)
+	}
+
+	logger, _ := logrus.NewNullLogger()
+	handle := handler.New(manager, handler.Config{
+		TokenPath: "/concourse/{{.Team}}/{{.Owner}}",
+		KeyPath:   "/concourse/{{.Team}}/{{.Repository}}",
+		KeyTitle:  "concourse-{{.Team}}-deploy-key",
+	}, logger)
+
+	result, err := handle(context.Background(), handler.Team{
+		Name: "test-team",
+		Repositories: []handler.Repository{
+			{Name: "cloud-repository", Owner: "telia-oss"},
+			{Name: "enterprise-repository", Owner: "business-unit"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, r := range result.Repositories {
+		if r.Status != handler.StatusCreated {
+			t.Errorf("got status %s for %s, want %s", r.Status, r.Name, handler.StatusCreated)
+		}
+	}
+
+	if got, want := len(cloud.keys["telia-oss/cloud-repository"]), 1; got != want {
+		t.Errorf("got %d keys on github.com, want %d", got, want)
+	}
+	if got, want := len(enterprise.keys["business-unit/enterprise-repository"]), 1; got != want {
+		t.Errorf("got %d keys on github enterprise, want %d", got, want)
+	}
+
+	count := func(requests []string, prefix string) (n int) {
+		for _, r := range requests {
+			if strings.HasPrefix(r, prefix) {
+				n++
+			}
+		}
+		return n
+	}
+	// Both apps list the installations on github.com (two pages each)
+	if got, want := count(cloud.requests, "GET /app/installations?"), 4; got != want {
+		t.Errorf("got %d requests for installations, want %d", got, want)
+	}
+	if got := count(cloud.requests, "GET /repos/business-unit/"); got != 0 {
+		t.Errorf("got %d requests for the enterprise owner on github.com", got)
+	}
+	if got := count(enterprise.requests, "POST /app/installations/7/access_tokens"); got == 0 {
+		t.Errorf("expected access tokens to be created on github enterprise")
+	}
+}
+
+func TestGithubEndpointSSHAddress(t *testing.T) {
+	tests := []struct {
+		endpoint handler.GithubEndpoint
+		expected string
+	}{
+		{endpoint: handler.GithubEndpoint{}, expected: "github.com:22"},
+		{endpoint: handler.GithubEndpoint{BaseURL: "https://github.example.com/api/v3/"}, expected: "github.example.com:22"},
+		{endpoint: handler.GithubEndpoint{BaseURL: "https://github.example.com/api/v3/", SSHHost: "ssh.example.com:2222"}, expected: "ssh.example.com:2222"},
+	}
+	for _, tc := range tests {
+		if got, want := tc.endpoint.SSHAddress(), tc.expected; got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestHandlerWorkers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fake := newFakeGithub(100)
+	team := handler.Team{Name: "test-team"}
+	for i := 1; i <= 20; i++ {
+		name := fmt.Sprintf("test-repository-%d", i)
+		fake.repositories["telia-oss/"+name] = &github.Repository{ID: github.Int64(int64(i)), Name: github.String(name)}
+		team.Repositories = append(team.Repositories, handler.Repository{Name: name, Owner: "telia-oss"})
+	}
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	var (
+		mu      sync.Mutex
+		written = make(map[string]int)
+	)
+	secrets := mocks.NewMockSecretsClient(ctrl)
+	expectNoPreviousToken(secrets)
+	secrets.EXPECT().CreateSecretWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+	secrets.EXPECT().UpdateSecretWithContext(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(_ aws.Context, in *secretsmanager.UpdateSecretInput, _ ...request.Option) (*secretsmanager.UpdateSecretOutput, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		written[aws.StringValue(in.SecretId)]++
+		return nil, nil
+	})
+
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+	}
+	manager := handler.NewTestManager(handler.NewTestSecretsManagerStore(secrets), handler.NewLocalKeyGenerator(), services, services, nil, nil)
+	logger, _ := logrus.NewNullLogger()
+	handle := handler.New(manager, handler.Config{
+		TokenPath: "/concourse/{{.Team}}/{{.Owner}}",
+		KeyPath:   "/concourse/{{.Team}}/{{.Repository}}",
+		KeyTitle:  "concourse-{{.Team}}-deploy-key",
+		Workers:   5,
+	}, logger)
+
+	result, err := handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for i, r := range result.Repositories {
+		if got, want := r.Name, team.Repositories[i].Name; got != want {
+			t.Errorf("got result for %s at index %d, want %s", got, i, want)
+		}
+		if got, want := r.Status, handler.StatusCreated; got != want {
+			t.Errorf("got status %s for %s, want %s", got, r.Name, want)
+		}
+		if got, want := len(fake.keys["telia-oss/"+r.Name]), 1; got != want {
+			t.Errorf("got %d keys for %s, want %d", got, r.Name, want)
+		}
+	}
+	if got, want := written["/concourse/test-team/telia-oss"], 1; got != want {
+		t.Errorf("got %d writes of the access token, want %d", got, want)
+	}
+}
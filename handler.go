@@ -1,120 +1,733 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/google/go-github/v29/github"
 	"github.com/sirupsen/logrus"
 )
 
+// Config for the lambda handler.
+type Config struct {
+	// TokenPath is the template for the secret path of access tokens.
+	TokenPath string
+
+	// KeyPath is the template for the secret path of private keys.
+	KeyPath string
+
+	// KeyTitle is the template for the title of deploy keys on Github.
+	KeyTitle string
+
+	// Lenient makes the handler return a nil error when repositories fail,
+	// in which case failures are only reported in the result.
+	Lenient bool
+
+	// DryRun only performs read calls and returns the planned changes.
+	DryRun bool
+
+	// KeyOverlap is how long old deploy keys are kept on Github after being rotated.
+	// Old keys are deleted by a later invocation once it has passed.
+	KeyOverlap time.Duration
+
+	// RotationInterval is the default interval for rotating deploy keys. Teams and
+	// repositories can override it, but it must be between the minimum and maximum.
+	RotationInterval    time.Duration
+	MinRotationInterval time.Duration
+	MaxRotationInterval time.Duration
+
+	// Workers is the number of repositories processed concurrently (defaults to 1).
+	Workers int
+
+	// Reconcile deletes deploy keys and secrets for repositories which have been removed from the
+	// team's config, once RemovalDelay has passed since the removal was first noticed.
+	Reconcile    bool
+	RemovalDelay time.Duration
+
+	// TokenGracePeriod is how long superseded access tokens remain valid after the new token has been
+	// written (in case a pipeline has just fetched the old token), before they are revoked.
+	TokenGracePeriod time.Duration
+
+	// Retries is the number of times writes to the secret store are retried when they fail with a
+	// transient error. The wait before each retry starts at RetryBackoff (1 second by default) and
+	// doubles for each attempt. Requests to Github are retried by the Github client instead.
+	Retries      int
+	RetryBackoff time.Duration
+
+	// DeadlineMargin is the minimum time that must remain before the deadline of the context for
+	// the handler to start processing a repository. Repositories which are not started are deferred.
+	DeadlineMargin time.Duration
+}
+
+// DefaultRotationInterval is used when the config does not specify a rotation interval.
+const DefaultRotationInterval = 7 * 24 * time.Hour
+
+// Validate the config.
+func (c Config) Validate() error {
+	if c.MinRotationInterval != 0 && c.MaxRotationInterval != 0 && c.MinRotationInterval > c.MaxRotationInterval {
+		return fmt.Errorf("minimum rotation interval (%s) is longer than the maximum (%s)", c.MinRotationInterval, c.MaxRotationInterval)
+	}
+	return c.validateRotationInterval(c.rotationInterval())
+}
+
+func (c Config) rotationInterval() time.Duration {
+	if c.RotationInterval == 0 {
+		return DefaultRotationInterval
+	}
+	return c.RotationInterval
+}
+
+func (c Config) workers() int {
+	if c.Workers < 1 {
+		return 1
+	}
+	return c.Workers
+}
+
+func (c Config) validateRotationInterval(d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("rotation interval must be positive: %s", d)
+	}
+	if c.MinRotationInterval != 0 && d < c.MinRotationInterval {
+		return fmt.Errorf("rotation interval (%s) is shorter than the minimum (%s)", d, c.MinRotationInterval)
+	}
+	if c.MaxRotationInterval != 0 && d > c.MaxRotationInterval {
+		return fmt.Errorf("rotation interval (%s) is longer than the maximum (%s)", d, c.MaxRotationInterval)
+	}
+	return nil
+}
+
+// Returns an error if there is not enough time left to start processing a repository.
+func (c Config) checkDeadline(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < c.DeadlineMargin {
+		return fmt.Errorf("less than %s remaining before the deadline", c.DeadlineMargin)
+	}
+	return nil
+}
+
 // New lambda handler with the provided settings.
-func New(manager *Manager, tokenTemplate, keyTemplate, titleTemplate string, logger *logrus.Logger) func(Team) error {
-	return func(team Team) error {
-		tokenAdded := make(map[string]bool)
-
-	Loop:
-		for _, repository := range team.Repositories {
-			log := logger.WithFields(logrus.Fields{
-				"team":       team.Name,
-				"repository": repository.Name,
-				"owner":      repository.Owner,
-			})
-
-			tokenPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, tokenTemplate).String()
-			if err != nil {
-				log.Warnf("failed to parse token path template: %s", err)
-				continue
-			}
+func New(manager *Manager, config Config, logger *logrus.Logger) func(context.Context, Team) (*Result, error) {
+	return func(ctx context.Context, team Team) (*Result, error) {
+		if team.Revoke != nil {
+			logger.WithFields(logrus.Fields{"team": team.Name, "owner": team.Revoke.Owner}).Warn("revoking deploy keys and access tokens")
+			return manager.Revoke(ctx, config, team, team.Revoke.Owner)
+		}
 
-			keyPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, keyTemplate).String()
+		if hasSelectors(team) {
+			repositories, err := manager.resolveRepositories(ctx, team)
 			if err != nil {
-				log.Warnf("failed to parse deploy key template: %s", err)
-				continue
+				return nil, fmt.Errorf("failed to resolve repository selectors: %s", err)
 			}
+			team.Repositories = repositories
 
-			title, err := NewTemplate(team.Name, repository.Name, repository.Owner, titleTemplate).String()
-			if err != nil {
-				log.Warnf("failed to github title template: %s", err)
-				continue
+			names := make([]string, len(repositories))
+			for i, r := range repositories {
+				names[i] = r.Owner + "/" + r.Name
 			}
+			logger.WithFields(logrus.Fields{"team": team.Name, "repositories": names}).Info("resolved repository selectors")
+		}
 
-			// Write an access token for the organisation
-			if _, ok := tokenAdded[repository.Owner]; !ok {
-				token, err := manager.createAccessToken(repository.Owner)
-				if err != nil {
-					log.Warnf("failed to get access token: %s", err)
-					continue
-				}
-				if err := manager.writeSecret(tokenPath, token); err != nil {
-					log.Warnf("failed to write access token: %s", err)
-					continue
-				}
-				tokenAdded[repository.Owner] = true
-			}
+		result := &Result{
+			Team:         team.Name,
+			DryRun:       config.DryRun || team.DryRun,
+			Repositories: make([]*RepositoryResult, len(team.Repositories)),
+		}
+		tokens := newTokenTracker()
+		lookup := newRepositoryLookup()
 
-			// Look for existing keys belongning to the team
-			keys, err := manager.listKeys(repository)
-			if err != nil {
-				log.Warnf("failed to list github keys: %s", err)
-				continue
-			}
+		jobs := make(chan int)
+		var wg sync.WaitGroup
+		for i := 0; i < config.workers(); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range jobs {
+					repository := team.Repositories[j]
+					log := logger.WithFields(logrus.Fields{
+						"team":       team.Name,
+						"repository": repository.Name,
+						"owner":      repository.Owner,
+					})
 
-			var oldKey *github.Key
-			for _, key := range keys {
-				if *key.Title == title {
-					oldKey = key
+					r := &RepositoryResult{
+						Name:  repository.Name,
+						Owner: repository.Owner,
+					}
+					result.Repositories[j] = r
 
-					// Rotate the key if read/write permissions have changed
-					if key.ReadOnly != nil && *key.ReadOnly != bool(repository.ReadOnly) {
-						break
+					// Do not start on repositories that might not finish before the lambda times out
+					if err := config.checkDeadline(ctx); err != nil {
+						log.Warnf("deferring repository: %s", err)
+						r.Status, r.Reason = StatusDeferred, err.Error()
+						continue
 					}
-					// Do not rotate if nothing has changed and the key is not >7 days old
-					updated, err := manager.getLastUpdated(keyPath)
-					if err != nil {
-						if e, ok := err.(awserr.Error); ok && e.Code() == secretsmanager.ErrCodeResourceNotFoundException {
-							// Do not log a warning if we fail to describe because the secret does not exist.
-							break
-						}
-						log.Warnf("failed to get last updated for secret: %s", err)
-						break
+
+					// Leave the remaining requests of the installations for other teams (and the next run)
+					if err := manager.checkRateLimit(repository.Owner); err != nil {
+						log.Warnf("deferring repository: %s", err)
+						r.Status, r.Reason = StatusDeferred, err.Error()
+						continue
 					}
-					if updated.After(time.Now().AddDate(0, 0, -7)) {
-						continue Loop
+
+					status, err := processRepository(ctx, manager, config, team, repository, result.DryRun, tokens, lookup, r, log)
+					r.Status = status
+					if err != nil {
+						log.Warn(err)
+						r.Status, r.Reason = StatusFailed, err.Error()
+					} else if r.RenamedTo != "" {
+						r.Status = StatusRenamed
 					}
 				}
-			}
+			}()
+		}
+		for i := range team.Repositories {
+			jobs <- i
+		}
+		close(jobs)
+		wg.Wait()
 
-			// Generate a new key pair
-			private, public, err := manager.generateKeyPair(title)
-			if err != nil {
-				log.Warnf("failed to generate new key pair: %s", err)
-				continue
+		if config.Reconcile {
+			log := logger.WithField("team", team.Name)
+			if err := config.checkDeadline(ctx); err != nil {
+				log.Warnf("skipping reconciliation: %s", err)
+			} else if err := reconcile(ctx, manager, config, team, result, log); err != nil {
+				log.Warnf("failed to reconcile removed repositories: %s", err)
+				if !config.Lenient {
+					return result, fmt.Errorf("failed to reconcile removed repositories: %s", err)
+				}
 			}
+		}
 
-			// Write the new public key to Github
-			if err = manager.createKey(repository, title, public); err != nil {
-				log.Warnf("failed to create key on github: %s", err)
-				continue
+		revokeSuperseded(ctx, manager, config, tokens.superseded, logger.WithField("team", team.Name))
+
+		var owners []string
+		for _, r := range team.Repositories {
+			owners = append(owners, r.Owner)
+		}
+		result.RateLimits = manager.rateLimits(owners)
+		for _, l := range result.RateLimits {
+			logger.WithFields(logrus.Fields{
+				"team":      team.Name,
+				"app":       l.App,
+				"owner":     l.Owner,
+				"limit":     l.Limit,
+				"remaining": l.Remaining,
+				"reset":     l.Reset.Format(time.RFC3339),
+			}).Info("github rate limit")
+		}
+
+		if err := result.Err(); err != nil && !config.Lenient {
+			return result, err
+		}
+		return result, nil
+	}
+}
+
+// tokenTracker makes sure the access token is only written once per owner when repositories
+// are processed concurrently. If writing the token fails, the next repository will retry.
+type tokenTracker struct {
+	mu         sync.Mutex
+	owners     map[string]*ownerToken
+	superseded []*supersededToken
+}
+
+// supersededToken is an access token which has been overwritten by a new token.
+type supersededToken struct {
+	owner   string
+	path    string
+	token   string
+	written time.Time
+	result  *RepositoryResult
+}
+
+type ownerToken struct {
+	sync.Mutex
+	done bool
+}
+
+func newTokenTracker() *tokenTracker {
+	return &tokenTracker{owners: make(map[string]*ownerToken)}
+}
+
+// Run f for the owner unless it has already succeeded.
+func (t *tokenTracker) once(owner string, f func() error) error {
+	t.mu.Lock()
+	o, ok := t.owners[owner]
+	if !ok {
+		o = &ownerToken{}
+		t.owners[owner] = o
+	}
+	t.mu.Unlock()
+
+	o.Lock()
+	defer o.Unlock()
+	if o.done {
+		return nil
+	}
+	if err := f(); err != nil {
+		return err
+	}
+	o.done = true
+	return nil
+}
+
+// repositoryLookup looks up each repository once per run. The result is used to report archived, disabled,
+// renamed and missing repositories before doing any key work, and to scope the access token using the
+// repositories of every team on the owner.
+type repositoryLookup struct {
+	mu           sync.Mutex
+	repositories map[string]*lookupResult
+}
+
+type lookupResult struct {
+	once       sync.Once
+	repository *github.Repository
+	err        error
+}
+
+func newRepositoryLookup() *repositoryLookup {
+	return &repositoryLookup{repositories: make(map[string]*lookupResult)}
+}
+
+func (l *repositoryLookup) get(ctx context.Context, manager *Manager, repository Repository) (*github.Repository, error) {
+	id := strings.ToLower(repository.Owner + "/" + repository.Name)
+	l.mu.Lock()
+	r, ok := l.repositories[id]
+	if !ok {
+		r = &lookupResult{}
+		l.repositories[id] = r
+	}
+	l.mu.Unlock()
+
+	r.once.Do(func() {
+		r.repository, r.err = manager.getRepository(ctx, repository)
+	})
+	return r.repository, r.err
+}
+
+// Record a superseded token to be revoked once the grace period has passed.
+func (t *tokenTracker) supersede(owner, path, token string, result *RepositoryResult) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.superseded = append(t.superseded, &supersededToken{
+		owner:   owner,
+		path:    path,
+		token:   token,
+		written: time.Now(),
+		result:  result,
+	})
+}
+
+// Revoke superseded access tokens once the grace period has passed. The wait is cut short if the grace
+// period would run into the deadline margin, and failures are only logged since the tokens expire anyway.
+func revokeSuperseded(ctx context.Context, manager *Manager, config Config, tokens []*supersededToken, log *logrus.Entry) {
+	if len(tokens) == 0 {
+		return
+	}
+	// Wait for the grace period of the most recently written token, so that none are revoked early.
+	latest := tokens[0].written
+	for _, t := range tokens[1:] {
+		if t.written.After(latest) {
+			latest = t.written
+		}
+	}
+	wait := config.TokenGracePeriod - time.Since(latest)
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline) - config.DeadlineMargin; remaining < wait {
+			wait = remaining
+		}
+	}
+	if wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+		}
+	}
+
+	for _, t := range tokens {
+		if err := manager.revokeAccessToken(ctx, t.owner, t.token); err != nil {
+			log.WithField("owner", t.owner).Warnf("failed to revoke superseded access token: %s", err)
+			continue
+		}
+		t.result.addAction(ActionRevokeToken, t.path)
+	}
+}
+
+// Write the access token (once per owner) and create or rotate the deploy key for a repository.
+// The changes are recorded as actions on the result, and only planned when dryRun is set.
+func processRepository(
+	ctx context.Context,
+	manager *Manager,
+	config Config,
+	team Team,
+	repository Repository,
+	dryRun bool,
+	tokens *tokenTracker,
+	lookup *repositoryLookup,
+	result *RepositoryResult,
+	log *logrus.Entry,
+) (Status, error) {
+	tokenPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.TokenPath).String()
+	if err != nil {
+		return StatusFailed, fmt.Errorf("failed to parse token path template: %s", err)
+	}
+
+	keyPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.KeyPath).String()
+	if err != nil {
+		return StatusFailed, fmt.Errorf("failed to parse deploy key template: %s", err)
+	}
+
+	title, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.KeyTitle).String()
+	if err != nil {
+		return StatusFailed, fmt.Errorf("failed to github title template: %s", err)
+	}
+
+	keyType := team.KeyTypeFor(repository)
+	if err := keyType.Validate(); err != nil {
+		return StatusFailed, fmt.Errorf("invalid key type: %s", err)
+	}
+
+	rotationInterval := team.RotationIntervalFor(repository, config.rotationInterval())
+	if err := config.validateRotationInterval(rotationInterval); err != nil {
+		return StatusFailed, fmt.Errorf("invalid rotation interval: %s", err)
+	}
+
+	// Look up the repository before doing any work, so that archived, disabled, deleted and transferred
+	// repositories get a status instead of failing on every run. The deploy keys of renamed repositories
+	// are managed under the new name (target), while the secrets, labels, key title and rotation state
+	// keep using the name in the config.
+	found, err := lookup.get(ctx, manager, repository)
+	if err != nil && !isNotFound(err) {
+		return StatusFailed, fmt.Errorf("failed to get repository: %s", err)
+	}
+	notice := func(status Status, reason string) (Status, error) {
+		if manager.firstNotice(team.Name + "/" + repository.Owner + "/" + repository.Name + ":" + string(status)) {
+			log.Warnf("skipping repository: %s", reason)
+		} else {
+			log.Debugf("skipping repository: %s", reason)
+		}
+		result.Reason = reason
+		return status, nil
+	}
+	switch {
+	case err != nil:
+		return notice(StatusNotFound, "repository does not exist or the github apps do not have access")
+	case found.GetArchived():
+		return notice(StatusSkippedArchived, "repository is archived")
+	case found.GetDisabled():
+		return notice(StatusSkippedDisabled, "repository is disabled")
+	}
+
+	target := repository
+	name, owner := found.GetName(), found.GetOwner().GetLogin()
+	if name == "" {
+		name = repository.Name
+	}
+	if owner == "" {
+		owner = repository.Owner
+	}
+	if !strings.EqualFold(name, repository.Name) || !strings.EqualFold(owner, repository.Owner) {
+		result.RenamedTo = owner + "/" + name
+		if !strings.EqualFold(owner, repository.Owner) {
+			return notice(StatusRenamed, "repository has been transferred to "+result.RenamedTo)
+		}
+		if manager.firstNotice(team.Name + "/" + repository.Owner + "/" + repository.Name + ":" + string(StatusRenamed)) {
+			log.Warnf("repository has been renamed to %s", result.RenamedTo)
+		}
+		target.Name = name
+	}
+
+	// Write an access token for the organisation, scoped to the team's repositories. When forced,
+	// the previous token is revoked as well.
+	err = tokens.once(repository.Owner, func() error {
+		var (
+			repositories []Repository
+			force        bool
+		)
+		for _, r := range team.Repositories {
+			if strings.EqualFold(r.Owner, repository.Owner) {
+				repositories = append(repositories, r)
+				force = force || team.ForceFor(r)
 			}
+		}
+		if dryRun {
+			result.addAction(ActionWriteSecret, tokenPath)
+			if _, err := manager.getLastUpdated(ctx, tokenPath); err == nil {
+				result.addAction(ActionRevokeToken, tokenPath)
+			}
+			return nil
+		}
 
-			// Write the private key to Secrets manager
-			if err := manager.writeSecret(keyPath, private); err != nil {
-				log.Warnf("failed to write secret key: %s", err)
-				continue
+		// Read the previous token before it is overwritten, so that it can be revoked. Failing to read it
+		// is only an error when forced, otherwise it is left to expire.
+		previous, err := manager.getSecret(ctx, tokenPath)
+		if err != nil && !errors.Is(err, ErrSecretNotFound) {
+			if force {
+				return fmt.Errorf("failed to read previous access token: %s", err)
 			}
+			log.Warnf("failed to read previous access token: %s", err)
+		}
 
-			// Sleep before deleting old key (in case someone has just fetched the old key)
-			if oldKey != nil {
-				time.Sleep(time.Second * 1)
-				if err = manager.deleteKey(repository, *oldKey.ID); err != nil {
-					log.Warnf("failed to delete old github key: %d: %s", *oldKey.ID, err)
-					continue
+		var ids []int64
+		for _, r := range repositories {
+			found, err := lookup.get(ctx, manager, r)
+			if err != nil {
+				// Missing repositories are reported (once) when they are processed
+				if !isNotFound(err) {
+					log.Warnf("failed to get repository id for access token: %s: %s", r.Name, err)
 				}
+				continue
 			}
+			ids = append(ids, found.GetID())
+		}
+		token, err := manager.createAccessToken(ctx, repository.Owner, ids, team.Permissions)
+		if err != nil {
+			return fmt.Errorf("failed to get access token: %s", err)
+		}
+		err = retry(ctx, config, func() error {
+			return manager.writeSecret(ctx, tokenPath, token, secretLabels(team.Name, repository.Owner, ""))
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write access token: %s", err)
+		}
+		result.addAction(ActionWriteSecret, tokenPath)
 
+		// The new token has been written at this point, so failing to revoke the previous one is only logged.
+		// When forced, the previous token is revoked right away instead of after the grace period.
+		if previous == "" || previous == token {
+			return nil
 		}
+		if !force {
+			tokens.supersede(repository.Owner, tokenPath, previous, result)
+			return nil
+		}
+		if err := manager.revokeAccessToken(ctx, repository.Owner, previous); err != nil {
+			log.Warnf("failed to revoke previous access token: %s", err)
+			return nil
+		}
+		result.addAction(ActionRevokeToken, tokenPath)
 		return nil
+	})
+	if err != nil {
+		return StatusFailed, err
+	}
+
+	// Look for existing keys belonging to the team. The newest key (highest ID) is the current
+	// key, and older keys with the same title have been superseded and are pending deletion.
+	keys, err := manager.listKeys(ctx, target)
+	if err != nil {
+		return StatusFailed, fmt.Errorf("failed to list github keys: %s", err)
+	}
+
+	var owned []*github.Key
+	for _, key := range keys {
+		if key.GetTitle() == title {
+			owned = append(owned, key)
+		}
+	}
+	current, pending := newestKey(owned)
+
+	// Rotate the key if it is forced, does not exist, or read/write permissions or the key type have changed
+	force := team.ForceFor(repository)
+	needsRotation := func() bool {
+		if force || current == nil || (current.ReadOnly != nil && current.GetReadOnly() != bool(repository.ReadOnly)) {
+			return true
+		}
+		t, err := publicKeyType(current.GetKey())
+		return err == nil && t != keyType
+	}
+	rotate := needsRotation()
+
+	// The time the current private key was written is used both to decide whether the key is due for
+	// rotation and when the overlap for superseded keys has passed.
+	var (
+		updated  *time.Time
+		recorded bool
+	)
+	getUpdated := func() {
+		updated, recorded, err = manager.getLastRotated(ctx, team.Name, repository, current.GetID(), keyPath)
+		if err != nil && !errors.Is(err, ErrSecretNotFound) {
+			// Do not log a warning if we fail to describe because the secret does not exist.
+			log.Warnf("failed to get last updated for secret: %s", err)
+		}
+	}
+	if current != nil && (!rotate || len(pending) > 0 || !current.GetCreatedAt().IsZero()) {
+		getUpdated()
+	}
+
+	// A key which is not recorded in the state and was created well after its private key was last written
+	// has no stored private key, which happens if a previous run failed to both write the private key and
+	// delete the new key again. It is deleted, and the newest superseded key becomes the current key again.
+	if current != nil && updated != nil && !recorded && current.GetCreatedAt().After(updated.Add(1*time.Minute)) {
+		log.Warnf("deleting deploy key without a stored private key: %d", current.GetID())
+		if err := deleteKeys(ctx, manager, target, []*github.Key{current}, dryRun, result); err != nil {
+			return StatusFailed, err
+		}
+		current, pending = newestKey(pending)
+		rotate, updated = needsRotation(), nil
+		if current != nil {
+			getUpdated()
+		}
+	}
+
+	// Do not rotate if nothing has changed and the key is not older than the rotation interval
+	if !rotate {
+		rotate = updated == nil || updated.Before(time.Now().Add(-rotationInterval))
+	}
+
+	// Delete superseded keys once the overlap has passed
+	if len(pending) > 0 && updated != nil && time.Since(*updated) >= config.KeyOverlap {
+		if err := deleteKeys(ctx, manager, target, pending, dryRun, result); err != nil {
+			return StatusFailed, err
+		}
+		pending = nil
+
+		if !rotate && !dryRun {
+			clearPendingDeletion(ctx, manager, team, repository, log)
+		}
+	}
+
+	if !rotate {
+		result.setPendingKeys(pending)
+		return StatusSkippedFresh, nil
+	}
+
+	status := StatusCreated
+	if current != nil {
+		status = StatusRotated
+		pending = append(pending, current)
+	}
+
+	var state *RepositoryState
+	if dryRun {
+		result.addAction(ActionCreateKey, title)
+		result.addAction(ActionWriteSecret, keyPath)
+	} else {
+		// Generate a new key pair
+		private, public, err := manager.generateKeyPair(ctx, title, keyType)
+		if err != nil {
+			return StatusFailed, fmt.Errorf("failed to generate new key pair: %s", err)
+		}
+
+		// Write the new public key to Github
+		key, err := manager.createKey(ctx, target, title, public)
+		if err != nil {
+			return StatusFailed, fmt.Errorf("failed to create key on github: %s", err)
+		}
+		result.addAction(ActionCreateKey, title)
+
+		// A new key which cannot be verified or whose private key cannot be written is deleted again,
+		// since it is of no use to anyone (and the previous key and private key are still in place).
+		rollback := func(reason string, err error) error {
+			if derr := manager.deleteKey(ctx, target, key.GetID()); derr != nil {
+				return fmt.Errorf("%s: %s (and failed to delete the new key %d: %s)", reason, err, key.GetID(), derr)
+			}
+			result.addAction(ActionDeleteKey, fmt.Sprintf("%d", key.GetID()))
+			return fmt.Errorf("%s (the new key has been deleted): %s", reason, err)
+		}
+
+		// Check that the new key works before anything is replaced
+		if err := manager.verifyKey(ctx, target, private); err != nil {
+			return StatusFailed, rollback("failed to verify new key", err)
+		}
+
+		// Write the private key to the secret store
+		err = retry(ctx, config, func() error {
+			return manager.writeSecret(ctx, keyPath, private, secretLabels(team.Name, repository.Owner, repository.Name))
+		})
+		if err != nil {
+			return StatusFailed, rollback("failed to write secret key", err)
+		}
+		result.addAction(ActionWriteSecret, keyPath)
+
+		fingerprint, err := publicKeyFingerprint(public)
+		if err != nil {
+			log.Warnf("failed to get fingerprint of public key: %s", err)
+		}
+		now := time.Now().UTC()
+		createdAt := key.GetCreatedAt().Time
+		if createdAt.IsZero() {
+			createdAt = now
+		}
+		state = &RepositoryState{
+			Team:        team.Name,
+			Owner:       repository.Owner,
+			Repository:  repository.Name,
+			KeyID:       key.GetID(),
+			Fingerprint: fingerprint,
+			KeyType:     keyType,
+			CreatedAt:   createdAt,
+			LastRotated: now,
+		}
+	}
+
+	// Keep the old keys around for the overlap (in case someone has just fetched the old key),
+	// they are deleted on a later invocation. Forced rotations assume the old key has leaked.
+	if config.KeyOverlap == 0 || force {
+		if err := deleteKeys(ctx, manager, target, pending, dryRun, result); err != nil {
+			return StatusFailed, err
+		}
+		pending = nil
+	}
+	result.setPendingKeys(pending)
+
+	// The key has been rotated at this point, so failing to record the state is only logged
+	// (in which case the next invocation falls back to the secret store).
+	if state != nil {
+		state.PendingDeletion = result.PendingKeys
+		if err := manager.putState(ctx, state); err != nil {
+			log.Warnf("failed to record rotation state: %s", err)
+		}
+	}
+	return status, nil
+}
+
+// Returns the newest key (highest ID) and the rest.
+func newestKey(keys []*github.Key) (newest *github.Key, rest []*github.Key) {
+	for _, key := range keys {
+		if newest == nil || key.GetID() > newest.GetID() {
+			if newest != nil {
+				rest = append(rest, newest)
+			}
+			newest = key
+			continue
+		}
+		rest = append(rest, key)
+	}
+	return newest, rest
+}
+
+// Clear the superseded keys recorded in the state after they have been deleted.
+func clearPendingDeletion(ctx context.Context, manager *Manager, team Team, repository Repository, log *logrus.Entry) {
+	state, err := manager.getState(ctx, team.Name, repository)
+	if err != nil {
+		if !errors.Is(err, ErrStateNotFound) {
+			log.Warnf("failed to get rotation state: %s", err)
+		}
+		return
+	}
+	state.PendingDeletion = nil
+	if err := manager.putState(ctx, state); err != nil {
+		log.Warnf("failed to record rotation state: %s", err)
+	}
+}
+
+// Delete (or plan the deletion of) deploy keys on Github.
+func deleteKeys(ctx context.Context, manager *Manager, repository Repository, keys []*github.Key, dryRun bool, result *RepositoryResult) error {
+	for _, key := range keys {
+		if !dryRun {
+			if err := manager.deleteKey(ctx, repository, key.GetID()); err != nil {
+				return fmt.Errorf("failed to delete old github key: %d: %s", key.GetID(), err)
+			}
+		}
+		result.addAction(ActionDeleteKey, fmt.Sprintf("%d", key.GetID()))
 	}
+	return nil
 }
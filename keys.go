@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyGenerator generates the key pairs used for deploy keys.
+type KeyGenerator interface {
+	// GenerateKeyPair returns a PEM encoded private key and a public key in authorized_keys format.
+	GenerateKeyPair(ctx context.Context, title string, keyType KeyType) (privateKey string, publicKey string, err error)
+}
+
+// NewLocalKeyGenerator returns a KeyGenerator which generates keys in-process.
+func NewLocalKeyGenerator() KeyGenerator {
+	return &localKeyGenerator{}
+}
+
+type localKeyGenerator struct{}
+
+// GenerateKeyPair implements KeyGenerator.
+func (g *localKeyGenerator) GenerateKeyPair(ctx context.Context, title string, keyType KeyType) (privateKey string, publicKey string, err error) {
+	var (
+		block  *pem.Block
+		public interface{}
+	)
+
+	switch keyType {
+	case KeyTypeRSA2048, KeyTypeRSA4096:
+		bits := 2048
+		if keyType == KeyTypeRSA4096 {
+			bits = 4096
+		}
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to generate rsa key: %s", err)
+		}
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+		public = &key.PublicKey
+
+	case KeyTypeECDSAP256:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to generate ecdsa key: %s", err)
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to marshal ecdsa key: %s", err)
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+		public = &key.PublicKey
+
+	case KeyTypeEd25519:
+		// Ed25519 keys have no PEM encoding that OpenSSH understands, so we use the OpenSSH format.
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to generate ed25519 key: %s", err)
+		}
+		block, err = marshalOpenSSHEd25519(key, title)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to marshal ed25519 key: %s", err)
+		}
+		public = pub
+
+	default:
+		return "", "", fmt.Errorf("unsupported key type: '%s'", keyType)
+	}
+
+	publicKey, err = marshalPublicKey(public)
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(block)), publicKey, nil
+}
+
+// NewEC2KeyGenerator returns a KeyGenerator which has EC2 generate the key pairs.
+func NewEC2KeyGenerator(sess *session.Session) KeyGenerator {
+	return NewTestEC2KeyGenerator(ec2.New(sess))
+}
+
+// NewTestEC2KeyGenerator for testing purposes.
+func NewTestEC2KeyGenerator(client EC2Client) KeyGenerator {
+	return &ec2KeyGenerator{client: client}
+}
+
+type ec2KeyGenerator struct {
+	client EC2Client
+}
+
+// GenerateKeyPair implements KeyGenerator.
+func (g *ec2KeyGenerator) GenerateKeyPair(ctx context.Context, title string, keyType KeyType) (privateKey string, publicKey string, err error) {
+	if keyType != KeyTypeRSA2048 {
+		return "", "", fmt.Errorf("key type is not supported by the ec2 key generator: '%s'", keyType)
+	}
+
+	// Use a unique name for the temporary key pair so that colliding titles do not fail.
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", "", fmt.Errorf("failed to generate key name: %s", err)
+	}
+	name := fmt.Sprintf("%s-%s", title, hex.EncodeToString(suffix))
+
+	// Have EC2 Generate a new private key
+	res, err := g.client.CreateKeyPairWithContext(ctx, &ec2.CreateKeyPairInput{
+		KeyName: aws.String(name),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	// Remember to clean up temporary key when done
+	defer func() {
+		_, e := g.client.DeleteKeyPairWithContext(ctx, &ec2.DeleteKeyPairInput{
+			KeyName: aws.String(name),
+		})
+		if e != nil && err == nil {
+			privateKey, publicKey, err = "", "", fmt.Errorf("failed to delete temporary key pair: %s: %s", name, e)
+		}
+	}()
+	privateKey = aws.StringValue(res.KeyMaterial)
+
+	// Parse the private key
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return "", "", errors.New("failed to decode private key")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	publicKey, err = marshalPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", err
+	}
+	return privateKey, publicKey, nil
+}
+
+// Marshal a public key to the authorized_keys format expected by Github.
+func marshalPublicKey(key interface{}) (string, error) {
+	public, err := ssh.NewPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(ssh.MarshalAuthorizedKey(public)), nil
+}
+
+// Marshal an unencrypted ed25519 private key to the OpenSSH format. See
+// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.key.
+func marshalOpenSSHEd25519(key ed25519.PrivateKey, comment string) (*pem.Block, error) {
+	public, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	check := make([]byte, 4)
+	if _, err := rand.Read(check); err != nil {
+		return nil, err
+	}
+
+	private := ssh.Marshal(struct {
+		Check1  uint32
+		Check2  uint32
+		Keytype string
+		Pub     []byte
+		Priv    []byte
+		Comment string
+	}{
+		Check1:  binary.BigEndian.Uint32(check),
+		Check2:  binary.BigEndian.Uint32(check),
+		Keytype: ssh.KeyAlgoED25519,
+		Pub:     []byte(key.Public().(ed25519.PublicKey)),
+		Priv:    []byte(key),
+		Comment: comment,
+	})
+
+	// Pad to the block size (8 for unencrypted keys) with 1, 2, 3...
+	for i := byte(1); len(private)%8 != 0; i++ {
+		private = append(private, i)
+	}
+
+	body := ssh.Marshal(struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumKeys:      1,
+		PubKey:       public.Marshal(),
+		PrivKeyBlock: private,
+	})
+
+	return &pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: append([]byte("openssh-key-v1\x00"), body...),
+	}, nil
+}
+
+// Determine the key type of a public key in authorized_keys format.
+func publicKeyType(publicKey string) (KeyType, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", err
+	}
+	crypto, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return "", fmt.Errorf("unsupported public key: %s", key.Type())
+	}
+
+	switch k := crypto.CryptoPublicKey().(type) {
+	case *rsa.PublicKey:
+		switch k.N.BitLen() {
+		case 2048:
+			return KeyTypeRSA2048, nil
+		case 4096:
+			return KeyTypeRSA4096, nil
+		}
+	case *ecdsa.PublicKey:
+		if k.Curve == elliptic.P256() {
+			return KeyTypeECDSAP256, nil
+		}
+	case ed25519.PublicKey:
+		return KeyTypeEd25519, nil
+	}
+	return "", fmt.Errorf("unsupported public key: %s", key.Type())
+}
+
+// Determine the SHA256 fingerprint of a public key in authorized_keys format.
+func publicKeyFingerprint(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintSHA256(key), nil
+}
@@ -2,27 +2,21 @@ package handler
 
 import (
 	"context"
-	"crypto/x509"
-	"encoding/pem"
 	"errors"
 	"fmt"
-	"regexp"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
-	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/google/go-github/v29/github"
-	"golang.org/x/crypto/ssh"
 )
 
 // RepoClient for testing purposes
 //go:generate mockgen -destination=mocks/mock_repo_client.go -package=mocks github.com/telia-oss/concourse-github-lambda RepoClient
 type RepoClient interface {
+	Get(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error)
 	ListKeys(ctx context.Context, owner string, repo string, opt *github.ListOptions) ([]*github.Key, *github.Response, error)
 	CreateKey(ctx context.Context, owner string, repo string, key *github.Key) (*github.Key, *github.Response, error)
 	DeleteKey(ctx context.Context, owner string, repo string, id int64) (*github.Response, error)
@@ -33,6 +27,13 @@ type RepoClient interface {
 type AppsClient interface {
 	ListRepos(ctx context.Context, opt *github.ListOptions) ([]*github.Repository, *github.Response, error)
 	CreateInstallationToken(ctx context.Context, id int64, opts *github.InstallationTokenOptions) (*github.InstallationToken, *github.Response, error)
+	RevokeInstallationToken(ctx context.Context) (*github.Response, error)
+}
+
+// TeamsClient for testing purposes
+//go:generate mockgen -destination=mocks/mock_teams_client.go -package=mocks github.com/telia-oss/concourse-github-lambda TeamsClient
+type TeamsClient interface {
+	ListTeamReposBySlug(ctx context.Context, org, slug string, opts *github.ListOptions) ([]*github.Repository, *github.Response, error)
 }
 
 // SecretsClient for testing purposes.
@@ -44,69 +45,205 @@ type SecretsClient secretsmanageriface.SecretsManagerAPI
 type EC2Client ec2iface.EC2API
 
 // NewTestManager for testing purposes.
-func NewTestManager(s SecretsClient, e EC2Client, tokenService, keyService *GithubApp) *Manager {
-	return &Manager{secretsClient: s, ec2Client: e, tokenService: tokenService, keyService: keyService}
+func NewTestManager(s SecretStore, k KeyGenerator, tokenService, keyService *GithubApp, state StateStore, verifier KeyVerifier) *Manager {
+	return &Manager{secretStore: s, keyGenerator: k, tokenService: tokenService, keyService: keyService, stateStore: state, keyVerifier: verifier}
 }
 
 // Manager handles API calls to AWS.
 type Manager struct {
-	tokenService  *GithubApp
-	keyService    *GithubApp
-	secretsClient SecretsClient
-	ec2Client     EC2Client
+	tokenService *GithubApp
+	keyService   *GithubApp
+	secretStore  SecretStore
+	keyGenerator KeyGenerator
+	stateStore   StateStore
+	keyVerifier  KeyVerifier
+
+	// notices holds the problems that have already been logged, so that a warm lambda does
+	// not warn about the same repository on every run.
+	notices sync.Map
 }
 
 // NewManager creates a new manager for handling rotation of Github deploy keys and access tokens.
+// The state store is optional, and if nil the rotation time is read from the secret store. The key
+// verifier is also optional, and if nil new keys are not verified.
 func NewManager(
-	sess *session.Session,
 	tokenServiceIntegrationID int64,
 	tokenServicePrivateKey string,
 	keyServiceIntegrationID int64,
 	keyServicePrivateKey string,
+	endpoints GithubEndpoints,
+	rateLimit RateLimitConfig,
+	keyGenerator KeyGenerator,
+	secretStore SecretStore,
+	stateStore StateStore,
+	keyVerifier KeyVerifier,
 ) (*Manager, error) {
-	tokenService, err := newGithubApp(tokenServiceIntegrationID, tokenServicePrivateKey)
+	tokenService, err := newGithubApp(
+		"token-service",
+		GithubAppCredentials{IntegrationID: tokenServiceIntegrationID, PrivateKey: tokenServicePrivateKey},
+		func(e GithubEndpoint) GithubAppCredentials { return e.TokenService },
+		endpoints,
+		rateLimit,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client for token service: %s", err)
 	}
 
-	keyService, err := newGithubApp(keyServiceIntegrationID, keyServicePrivateKey)
+	keyService, err := newGithubApp(
+		"key-service",
+		GithubAppCredentials{IntegrationID: keyServiceIntegrationID, PrivateKey: keyServicePrivateKey},
+		func(e GithubEndpoint) GithubAppCredentials { return e.KeyService },
+		endpoints,
+		rateLimit,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client for key service: %s", err)
 	}
 
 	return &Manager{
-		tokenService:  tokenService,
-		keyService:    keyService,
-		secretsClient: secretsmanager.New(sess),
-		ec2Client:     ec2.New(sess),
+		tokenService: tokenService,
+		keyService:   keyService,
+		secretStore:  secretStore,
+		keyGenerator: keyGenerator,
+		stateStore:   stateStore,
+		keyVerifier:  keyVerifier,
 	}, nil
 }
 
-// Create an access token for the organisation
-func (m *Manager) createAccessToken(owner string) (string, error) {
-	token, _, err := m.tokenService.createInstallationToken(owner)
+// Create an access token for the organisation, scoped to the given repositories and permissions.
+func (m *Manager) createAccessToken(ctx context.Context, owner string, repositoryIDs []int64, permissions *github.InstallationPermissions) (string, error) {
+	if len(repositoryIDs) == 0 {
+		return "", errors.New("refusing to create an access token without any repositories")
+	}
+	token, _, err := m.tokenService.createInstallationToken(ctx, owner, &github.InstallationTokenOptions{
+		RepositoryIDs: repositoryIDs,
+		Permissions:   permissions,
+	})
 	return token, err
 }
 
+// Revoke an access token created by the token service.
+func (m *Manager) revokeAccessToken(ctx context.Context, owner, token string) error {
+	return m.tokenService.revokeInstallationToken(ctx, owner, token)
+}
+
+// Look up a repository using the key service, which does the key work for it, or the token service if
+// the key service cannot see it. Renamed repositories are returned under their new name, since the client
+// follows Github's redirects.
+func (m *Manager) getRepository(ctx context.Context, repository Repository) (*github.Repository, error) {
+	repo, err := lookupRepository(ctx, m.keyService, repository)
+	if isNotFound(err) {
+		return lookupRepository(ctx, m.tokenService, repository)
+	}
+	return repo, err
+}
+
+func lookupRepository(ctx context.Context, app *GithubApp, repository Repository) (*github.Repository, error) {
+	client, err := app.getInstallationClient(ctx, repository.Owner)
+	if err != nil {
+		return nil, err
+	}
+	repo, _, err := client.Repos.Get(ctx, repository.Owner, repository.Name)
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
 // List deploy keys for a repository
-func (m *Manager) listKeys(repository Repository) ([]*github.Key, error) {
-	client, err := m.keyService.getInstallationClient(repository.Owner)
+func (m *Manager) listKeys(ctx context.Context, repository Repository) ([]*github.Key, error) {
+	client, err := m.keyService.getInstallationClient(ctx, repository.Owner)
 	if err != nil {
 		return nil, err
 	}
-	keys, _, err := client.Repos.ListKeys(context.TODO(), repository.Owner, repository.Name, nil)
+	var keys []*github.Key
+	err = paginate(func(opts *github.ListOptions) (*github.Response, error) {
+		page, res, err := client.Repos.ListKeys(ctx, repository.Owner, repository.Name, opts)
+		keys = append(keys, page...)
+		return res, err
+	})
 	if err != nil {
 		return nil, err
 	}
 	return keys, nil
 }
 
-// Create deploy key for a repository
-func (m *Manager) createKey(repository Repository, title, publicKey string) error {
-	client, err := m.keyService.getInstallationClient(repository.Owner)
+// List the repositories the key service is installed on for an owner.
+func (m *Manager) listRepositories(ctx context.Context, owner string) ([]*github.Repository, error) {
+	client, err := m.keyService.getInstallationClient(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	var repositories []*github.Repository
+	err = paginate(func(opts *github.ListOptions) (*github.Response, error) {
+		page, res, err := client.Apps.ListRepos(ctx, opts)
+		repositories = append(repositories, page...)
+		return res, err
+	})
 	if err != nil {
+		return nil, err
+	}
+	return repositories, nil
+}
+
+// List the repositories a Github team (by slug) has access to on an organisation
+func (m *Manager) listTeamRepositories(ctx context.Context, owner, slug string) ([]*github.Repository, error) {
+	client, err := m.keyService.getInstallationClient(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	var repositories []*github.Repository
+	err = paginate(func(opts *github.ListOptions) (*github.Response, error) {
+		page, res, err := client.Teams.ListTeamReposBySlug(ctx, owner, slug, opts)
+		repositories = append(repositories, page...)
+		return res, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repositories, nil
+}
+
+// Returns the remaining quota for the installations of both Github Apps on the owners.
+func (m *Manager) rateLimits(owners []string) []RateLimit {
+	apps := []*GithubApp{m.tokenService}
+	if m.keyService != m.tokenService {
+		apps = append(apps, m.keyService)
+	}
+	var limits []RateLimit
+	for _, app := range apps {
+		for _, limit := range app.rateLimits() {
+			for _, owner := range owners {
+				if strings.EqualFold(limit.Owner, owner) {
+					limits = append(limits, limit)
+					break
+				}
+			}
+		}
+	}
+	return limits
+}
+
+// Returns an error if either of the Github Apps is running low on requests for the owner.
+func (m *Manager) checkRateLimit(owner string) error {
+	if err := m.tokenService.checkRateLimit(owner); err != nil {
 		return err
 	}
+	return m.keyService.checkRateLimit(owner)
+}
+
+// Returns true the first time a notice (e.g. that a repository is archived) is seen by the manager.
+func (m *Manager) firstNotice(notice string) bool {
+	_, seen := m.notices.LoadOrStore(notice, true)
+	return !seen
+}
+
+// Create deploy key for a repository
+func (m *Manager) createKey(ctx context.Context, repository Repository, title, publicKey string) (*github.Key, error) {
+	client, err := m.keyService.getInstallationClient(ctx, repository.Owner)
+	if err != nil {
+		return nil, err
+	}
 	input := &github.Key{
 		ID:       nil,
 		Key:      github.String(publicKey),
@@ -115,108 +252,131 @@ func (m *Manager) createKey(repository Repository, title, publicKey string) erro
 		ReadOnly: github.Bool(bool(repository.ReadOnly)),
 	}
 
-	_, _, err = client.Repos.CreateKey(context.TODO(), repository.Owner, repository.Name, input)
-	return err
+	key, _, err := client.Repos.CreateKey(ctx, repository.Owner, repository.Name, input)
+	return key, err
 }
 
 // Delete a deploy key.
-func (m *Manager) deleteKey(repository Repository, id int64) error {
-	client, err := m.keyService.getInstallationClient(repository.Owner)
+func (m *Manager) deleteKey(ctx context.Context, repository Repository, id int64) error {
+	client, err := m.keyService.getInstallationClient(ctx, repository.Owner)
 	if err != nil {
 		return err
 	}
-	_, err = client.Repos.DeleteKey(context.TODO(), repository.Owner, repository.Name, id)
+	_, err = client.Repos.DeleteKey(ctx, repository.Owner, repository.Name, id)
 	return err
 }
 
-// Get the time the secret was last updated by this lambda from the secret description.
-// Note that we are not using LastChangedDate from secrets manager because in practice
-// this timestamp is updated daily by the inner workings of secrets manager.
-func (m *Manager) getLastUpdated(name string) (*time.Time, error) {
-	out, err := m.secretsClient.DescribeSecret(&secretsmanager.DescribeSecretInput{
-		SecretId: aws.String(name),
-	})
+// Get the time the secret was last updated by this lambda.
+func (m *Manager) getLastUpdated(ctx context.Context, name string) (*time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	metadata, err := m.secretStore.GetMetadata(ctx, name)
 	if err != nil {
 		return nil, err
 	}
+	return &metadata.LastUpdated, nil
+}
 
-	re := regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z`)
-	ds := re.FindString(aws.StringValue(out.Description))
-
-	if ds == "" {
-		return nil, fmt.Errorf("failed to find timestamp in description: %s", aws.StringValue(out.Description))
+// Get the time the deploy key with the given ID was rotated. The state store is used if it has
+// recorded the key (in which case recorded is true), otherwise we fall back to the time the secret was last updated.
+func (m *Manager) getLastRotated(ctx context.Context, team string, repository Repository, keyID int64, secretName string) (rotated *time.Time, recorded bool, err error) {
+	state, err := m.getState(ctx, team, repository)
+	if err != nil && !errors.Is(err, ErrStateNotFound) {
+		return nil, false, err
+	}
+	if state != nil && state.KeyID == keyID {
+		return &state.LastRotated, true, nil
 	}
+	rotated, err = m.getLastUpdated(ctx, secretName)
+	return rotated, false, err
+}
 
-	t, err := time.Parse(time.RFC3339, ds)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse timestamp: %s", err)
+// Verify that a new deploy key works (if there is a key verifier).
+func (m *Manager) verifyKey(ctx context.Context, repository Repository, privateKey string) error {
+	if m.keyVerifier == nil {
+		return nil
 	}
+	return m.keyVerifier.VerifyKey(ctx, repository, privateKey)
+}
 
-	return &t, nil
+// Get the rotation state for a team's repository. Returns ErrStateNotFound if there is no state store.
+func (m *Manager) getState(ctx context.Context, team string, repository Repository) (*RepositoryState, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if m.stateStore == nil {
+		return nil, ErrStateNotFound
+	}
+	return m.stateStore.GetState(ctx, team, repository.Owner, repository.Name)
 }
 
-// Write a secret to secrets manager.
-func (m *Manager) writeSecret(name, secret string) error {
-	var err error
-	timestamp := time.Now().UTC().Format(time.RFC3339)
+// Record the rotation state for a team's repository (if there is a state store).
+func (m *Manager) putState(ctx context.Context, state *RepositoryState) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if m.stateStore == nil {
+		return nil
+	}
+	return m.stateStore.PutState(ctx, state)
+}
 
-	_, err = m.secretsClient.CreateSecret(&secretsmanager.CreateSecretInput{
-		Name:        aws.String(name),
-		Description: aws.String(fmt.Sprintf("Github credentials for Concourse. Last updated: %s", timestamp)),
-	})
-	if err != nil {
-		e, ok := err.(awserr.Error)
-		if !ok {
-			return fmt.Errorf("failed to convert error: %s", err)
-		}
-		if e.Code() != secretsmanager.ErrCodeResourceExistsException {
-			return err
-		}
+// Delete the rotation state for a team's repository (if there is a state store).
+func (m *Manager) deleteState(ctx context.Context, team string, repository Repository) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
+	if m.stateStore == nil {
+		return nil
+	}
+	return m.stateStore.DeleteState(ctx, team, repository.Owner, repository.Name)
+}
 
-	_, err = m.secretsClient.UpdateSecret(&secretsmanager.UpdateSecretInput{
-		Description:  aws.String(fmt.Sprintf("Github credentials for Concourse. Last updated: %s", timestamp)),
-		SecretId:     aws.String(name),
-		SecretString: aws.String(secret),
-	})
-	return err
+// Read a secret from the secret store.
+func (m *Manager) getSecret(ctx context.Context, name string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return m.secretStore.GetSecret(ctx, name)
 }
 
-// Generate a key pair for the deploy key.
-func (m *Manager) generateKeyPair(title string) (privateKey string, publicKey string, err error) {
-	// Have EC2 Generate a new private key
-	res, err := m.ec2Client.CreateKeyPair(&ec2.CreateKeyPairInput{
-		KeyName: aws.String(title),
-	})
-	if err != nil {
-		return "", "", err
+// Write a secret to the secret store.
+func (m *Manager) writeSecret(ctx context.Context, name, secret string, labels map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
+	return m.secretStore.WriteSecret(ctx, name, secret, labels)
+}
 
-	// Remember to clean up temporary key when done
-	defer func() {
-		// TODO: Don't discard error, handle it somehow.
-		m.ec2Client.DeleteKeyPair(&ec2.DeleteKeyPairInput{
-			KeyName: aws.String(title),
-		})
-	}()
-	privateKey = aws.StringValue(res.KeyMaterial)
+// List the secrets with the given prefix.
+func (m *Manager) listSecrets(ctx context.Context, prefix string) ([]*SecretMetadata, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return m.secretStore.ListSecrets(ctx, prefix)
+}
 
-	// Parse the private key
-	block, _ := pem.Decode([]byte(privateKey))
-	if block == nil {
-		return "", "", errors.New("failed to decode private key")
+// Add, update or remove (if the value is empty) labels on a secret.
+func (m *Manager) setSecretLabels(ctx context.Context, name string, labels map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
+	return m.secretStore.SetLabels(ctx, name, labels)
+}
 
-	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
-	if err != nil {
-		return "", "", err
+// Delete a secret from the secret store.
+func (m *Manager) deleteSecret(ctx context.Context, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
+	return m.secretStore.DeleteSecret(ctx, name)
+}
 
-	public, err := ssh.NewPublicKey(&key.PublicKey)
-	if err != nil {
+// Generate a key pair for the deploy key.
+func (m *Manager) generateKeyPair(ctx context.Context, title string, keyType KeyType) (privateKey string, publicKey string, err error) {
+	if err := ctx.Err(); err != nil {
 		return "", "", err
 	}
-	publicKey = string(ssh.MarshalAuthorizedKey(public))
-
-	return privateKey, publicKey, nil
+	return m.keyGenerator.GenerateKeyPair(ctx, title, keyType)
 }
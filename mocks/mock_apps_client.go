@@ -65,3 +65,18 @@ func (mr *MockAppsClientMockRecorder) ListRepos(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepos", reflect.TypeOf((*MockAppsClient)(nil).ListRepos), arg0, arg1)
 }
+
+// RevokeInstallationToken mocks base method
+func (m *MockAppsClient) RevokeInstallationToken(arg0 context.Context) (*github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInstallationToken", arg0)
+	ret0, _ := ret[0].(*github.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInstallationToken indicates an expected call of RevokeInstallationToken
+func (mr *MockAppsClientMockRecorder) RevokeInstallationToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInstallationToken", reflect.TypeOf((*MockAppsClient)(nil).RevokeInstallationToken), arg0)
+}
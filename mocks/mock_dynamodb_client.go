@@ -0,0 +1,2317 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/telia-oss/concourse-github-lambda (interfaces: DynamoDBClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	request "github.com/aws/aws-sdk-go/aws/request"
+	dynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockDynamoDBClient is a mock of DynamoDBClient interface
+type MockDynamoDBClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDynamoDBClientMockRecorder
+}
+
+// MockDynamoDBClientMockRecorder is the mock recorder for MockDynamoDBClient
+type MockDynamoDBClientMockRecorder struct {
+	mock *MockDynamoDBClient
+}
+
+// NewMockDynamoDBClient creates a new mock instance
+func NewMockDynamoDBClient(ctrl *gomock.Controller) *MockDynamoDBClient {
+	mock := &MockDynamoDBClient{ctrl: ctrl}
+	mock.recorder = &MockDynamoDBClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDynamoDBClient) EXPECT() *MockDynamoDBClientMockRecorder {
+	return m.recorder
+}
+
+// BatchGetItem mocks base method
+func (m *MockDynamoDBClient) BatchGetItem(arg0 *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetItem", arg0)
+	ret0, _ := ret[0].(*dynamodb.BatchGetItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetItem indicates an expected call of BatchGetItem
+func (mr *MockDynamoDBClientMockRecorder) BatchGetItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetItem", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchGetItem), arg0)
+}
+
+// BatchGetItemPages mocks base method
+func (m *MockDynamoDBClient) BatchGetItemPages(arg0 *dynamodb.BatchGetItemInput, arg1 func(*dynamodb.BatchGetItemOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetItemPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchGetItemPages indicates an expected call of BatchGetItemPages
+func (mr *MockDynamoDBClientMockRecorder) BatchGetItemPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetItemPages", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchGetItemPages), arg0, arg1)
+}
+
+// BatchGetItemPagesWithContext mocks base method
+func (m *MockDynamoDBClient) BatchGetItemPagesWithContext(arg0 context.Context, arg1 *dynamodb.BatchGetItemInput, arg2 func(*dynamodb.BatchGetItemOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetItemPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchGetItemPagesWithContext indicates an expected call of BatchGetItemPagesWithContext
+func (mr *MockDynamoDBClientMockRecorder) BatchGetItemPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetItemPagesWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchGetItemPagesWithContext), varargs...)
+}
+
+// BatchGetItemRequest mocks base method
+func (m *MockDynamoDBClient) BatchGetItemRequest(arg0 *dynamodb.BatchGetItemInput) (*request.Request, *dynamodb.BatchGetItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.BatchGetItemOutput)
+	return ret0, ret1
+}
+
+// BatchGetItemRequest indicates an expected call of BatchGetItemRequest
+func (mr *MockDynamoDBClientMockRecorder) BatchGetItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetItemRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchGetItemRequest), arg0)
+}
+
+// BatchGetItemWithContext mocks base method
+func (m *MockDynamoDBClient) BatchGetItemWithContext(arg0 context.Context, arg1 *dynamodb.BatchGetItemInput, arg2 ...request.Option) (*dynamodb.BatchGetItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetItemWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.BatchGetItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetItemWithContext indicates an expected call of BatchGetItemWithContext
+func (mr *MockDynamoDBClientMockRecorder) BatchGetItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetItemWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchGetItemWithContext), varargs...)
+}
+
+// BatchWriteItem mocks base method
+func (m *MockDynamoDBClient) BatchWriteItem(arg0 *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchWriteItem", arg0)
+	ret0, _ := ret[0].(*dynamodb.BatchWriteItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchWriteItem indicates an expected call of BatchWriteItem
+func (mr *MockDynamoDBClientMockRecorder) BatchWriteItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchWriteItem", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchWriteItem), arg0)
+}
+
+// BatchWriteItemRequest mocks base method
+func (m *MockDynamoDBClient) BatchWriteItemRequest(arg0 *dynamodb.BatchWriteItemInput) (*request.Request, *dynamodb.BatchWriteItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchWriteItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.BatchWriteItemOutput)
+	return ret0, ret1
+}
+
+// BatchWriteItemRequest indicates an expected call of BatchWriteItemRequest
+func (mr *MockDynamoDBClientMockRecorder) BatchWriteItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchWriteItemRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchWriteItemRequest), arg0)
+}
+
+// BatchWriteItemWithContext mocks base method
+func (m *MockDynamoDBClient) BatchWriteItemWithContext(arg0 context.Context, arg1 *dynamodb.BatchWriteItemInput, arg2 ...request.Option) (*dynamodb.BatchWriteItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchWriteItemWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.BatchWriteItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchWriteItemWithContext indicates an expected call of BatchWriteItemWithContext
+func (mr *MockDynamoDBClientMockRecorder) BatchWriteItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchWriteItemWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).BatchWriteItemWithContext), varargs...)
+}
+
+// CreateBackup mocks base method
+func (m *MockDynamoDBClient) CreateBackup(arg0 *dynamodb.CreateBackupInput) (*dynamodb.CreateBackupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBackup", arg0)
+	ret0, _ := ret[0].(*dynamodb.CreateBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackup indicates an expected call of CreateBackup
+func (mr *MockDynamoDBClientMockRecorder) CreateBackup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackup", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateBackup), arg0)
+}
+
+// CreateBackupRequest mocks base method
+func (m *MockDynamoDBClient) CreateBackupRequest(arg0 *dynamodb.CreateBackupInput) (*request.Request, *dynamodb.CreateBackupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBackupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.CreateBackupOutput)
+	return ret0, ret1
+}
+
+// CreateBackupRequest indicates an expected call of CreateBackupRequest
+func (mr *MockDynamoDBClientMockRecorder) CreateBackupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateBackupRequest), arg0)
+}
+
+// CreateBackupWithContext mocks base method
+func (m *MockDynamoDBClient) CreateBackupWithContext(arg0 context.Context, arg1 *dynamodb.CreateBackupInput, arg2 ...request.Option) (*dynamodb.CreateBackupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateBackupWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.CreateBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackupWithContext indicates an expected call of CreateBackupWithContext
+func (mr *MockDynamoDBClientMockRecorder) CreateBackupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateBackupWithContext), varargs...)
+}
+
+// CreateGlobalTable mocks base method
+func (m *MockDynamoDBClient) CreateGlobalTable(arg0 *dynamodb.CreateGlobalTableInput) (*dynamodb.CreateGlobalTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGlobalTable", arg0)
+	ret0, _ := ret[0].(*dynamodb.CreateGlobalTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGlobalTable indicates an expected call of CreateGlobalTable
+func (mr *MockDynamoDBClientMockRecorder) CreateGlobalTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalTable", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateGlobalTable), arg0)
+}
+
+// CreateGlobalTableRequest mocks base method
+func (m *MockDynamoDBClient) CreateGlobalTableRequest(arg0 *dynamodb.CreateGlobalTableInput) (*request.Request, *dynamodb.CreateGlobalTableOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGlobalTableRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.CreateGlobalTableOutput)
+	return ret0, ret1
+}
+
+// CreateGlobalTableRequest indicates an expected call of CreateGlobalTableRequest
+func (mr *MockDynamoDBClientMockRecorder) CreateGlobalTableRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalTableRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateGlobalTableRequest), arg0)
+}
+
+// CreateGlobalTableWithContext mocks base method
+func (m *MockDynamoDBClient) CreateGlobalTableWithContext(arg0 context.Context, arg1 *dynamodb.CreateGlobalTableInput, arg2 ...request.Option) (*dynamodb.CreateGlobalTableOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGlobalTableWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.CreateGlobalTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGlobalTableWithContext indicates an expected call of CreateGlobalTableWithContext
+func (mr *MockDynamoDBClientMockRecorder) CreateGlobalTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalTableWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateGlobalTableWithContext), varargs...)
+}
+
+// CreateTable mocks base method
+func (m *MockDynamoDBClient) CreateTable(arg0 *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTable", arg0)
+	ret0, _ := ret[0].(*dynamodb.CreateTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTable indicates an expected call of CreateTable
+func (mr *MockDynamoDBClientMockRecorder) CreateTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTable", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateTable), arg0)
+}
+
+// CreateTableRequest mocks base method
+func (m *MockDynamoDBClient) CreateTableRequest(arg0 *dynamodb.CreateTableInput) (*request.Request, *dynamodb.CreateTableOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTableRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.CreateTableOutput)
+	return ret0, ret1
+}
+
+// CreateTableRequest indicates an expected call of CreateTableRequest
+func (mr *MockDynamoDBClientMockRecorder) CreateTableRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTableRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateTableRequest), arg0)
+}
+
+// CreateTableWithContext mocks base method
+func (m *MockDynamoDBClient) CreateTableWithContext(arg0 context.Context, arg1 *dynamodb.CreateTableInput, arg2 ...request.Option) (*dynamodb.CreateTableOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTableWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.CreateTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTableWithContext indicates an expected call of CreateTableWithContext
+func (mr *MockDynamoDBClientMockRecorder) CreateTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTableWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).CreateTableWithContext), varargs...)
+}
+
+// DeleteBackup mocks base method
+func (m *MockDynamoDBClient) DeleteBackup(arg0 *dynamodb.DeleteBackupInput) (*dynamodb.DeleteBackupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackup", arg0)
+	ret0, _ := ret[0].(*dynamodb.DeleteBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackup indicates an expected call of DeleteBackup
+func (mr *MockDynamoDBClientMockRecorder) DeleteBackup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackup", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteBackup), arg0)
+}
+
+// DeleteBackupRequest mocks base method
+func (m *MockDynamoDBClient) DeleteBackupRequest(arg0 *dynamodb.DeleteBackupInput) (*request.Request, *dynamodb.DeleteBackupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DeleteBackupOutput)
+	return ret0, ret1
+}
+
+// DeleteBackupRequest indicates an expected call of DeleteBackupRequest
+func (mr *MockDynamoDBClientMockRecorder) DeleteBackupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteBackupRequest), arg0)
+}
+
+// DeleteBackupWithContext mocks base method
+func (m *MockDynamoDBClient) DeleteBackupWithContext(arg0 context.Context, arg1 *dynamodb.DeleteBackupInput, arg2 ...request.Option) (*dynamodb.DeleteBackupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBackupWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DeleteBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupWithContext indicates an expected call of DeleteBackupWithContext
+func (mr *MockDynamoDBClientMockRecorder) DeleteBackupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteBackupWithContext), varargs...)
+}
+
+// DeleteItem mocks base method
+func (m *MockDynamoDBClient) DeleteItem(arg0 *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItem", arg0)
+	ret0, _ := ret[0].(*dynamodb.DeleteItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteItem indicates an expected call of DeleteItem
+func (mr *MockDynamoDBClientMockRecorder) DeleteItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteItem), arg0)
+}
+
+// DeleteItemRequest mocks base method
+func (m *MockDynamoDBClient) DeleteItemRequest(arg0 *dynamodb.DeleteItemInput) (*request.Request, *dynamodb.DeleteItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DeleteItemOutput)
+	return ret0, ret1
+}
+
+// DeleteItemRequest indicates an expected call of DeleteItemRequest
+func (mr *MockDynamoDBClientMockRecorder) DeleteItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItemRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteItemRequest), arg0)
+}
+
+// DeleteItemWithContext mocks base method
+func (m *MockDynamoDBClient) DeleteItemWithContext(arg0 context.Context, arg1 *dynamodb.DeleteItemInput, arg2 ...request.Option) (*dynamodb.DeleteItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteItemWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DeleteItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteItemWithContext indicates an expected call of DeleteItemWithContext
+func (mr *MockDynamoDBClientMockRecorder) DeleteItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItemWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteItemWithContext), varargs...)
+}
+
+// DeleteTable mocks base method
+func (m *MockDynamoDBClient) DeleteTable(arg0 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTable", arg0)
+	ret0, _ := ret[0].(*dynamodb.DeleteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTable indicates an expected call of DeleteTable
+func (mr *MockDynamoDBClientMockRecorder) DeleteTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTable", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteTable), arg0)
+}
+
+// DeleteTableRequest mocks base method
+func (m *MockDynamoDBClient) DeleteTableRequest(arg0 *dynamodb.DeleteTableInput) (*request.Request, *dynamodb.DeleteTableOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTableRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DeleteTableOutput)
+	return ret0, ret1
+}
+
+// DeleteTableRequest indicates an expected call of DeleteTableRequest
+func (mr *MockDynamoDBClientMockRecorder) DeleteTableRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTableRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteTableRequest), arg0)
+}
+
+// DeleteTableWithContext mocks base method
+func (m *MockDynamoDBClient) DeleteTableWithContext(arg0 context.Context, arg1 *dynamodb.DeleteTableInput, arg2 ...request.Option) (*dynamodb.DeleteTableOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTableWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DeleteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTableWithContext indicates an expected call of DeleteTableWithContext
+func (mr *MockDynamoDBClientMockRecorder) DeleteTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTableWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DeleteTableWithContext), varargs...)
+}
+
+// DescribeBackup mocks base method
+func (m *MockDynamoDBClient) DescribeBackup(arg0 *dynamodb.DescribeBackupInput) (*dynamodb.DescribeBackupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBackup", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBackup indicates an expected call of DescribeBackup
+func (mr *MockDynamoDBClientMockRecorder) DescribeBackup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBackup", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeBackup), arg0)
+}
+
+// DescribeBackupRequest mocks base method
+func (m *MockDynamoDBClient) DescribeBackupRequest(arg0 *dynamodb.DescribeBackupInput) (*request.Request, *dynamodb.DescribeBackupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBackupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeBackupOutput)
+	return ret0, ret1
+}
+
+// DescribeBackupRequest indicates an expected call of DescribeBackupRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeBackupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBackupRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeBackupRequest), arg0)
+}
+
+// DescribeBackupWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeBackupWithContext(arg0 context.Context, arg1 *dynamodb.DescribeBackupInput, arg2 ...request.Option) (*dynamodb.DescribeBackupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeBackupWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBackupWithContext indicates an expected call of DescribeBackupWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeBackupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBackupWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeBackupWithContext), varargs...)
+}
+
+// DescribeContinuousBackups mocks base method
+func (m *MockDynamoDBClient) DescribeContinuousBackups(arg0 *dynamodb.DescribeContinuousBackupsInput) (*dynamodb.DescribeContinuousBackupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeContinuousBackups", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeContinuousBackupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeContinuousBackups indicates an expected call of DescribeContinuousBackups
+func (mr *MockDynamoDBClientMockRecorder) DescribeContinuousBackups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContinuousBackups", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeContinuousBackups), arg0)
+}
+
+// DescribeContinuousBackupsRequest mocks base method
+func (m *MockDynamoDBClient) DescribeContinuousBackupsRequest(arg0 *dynamodb.DescribeContinuousBackupsInput) (*request.Request, *dynamodb.DescribeContinuousBackupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeContinuousBackupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeContinuousBackupsOutput)
+	return ret0, ret1
+}
+
+// DescribeContinuousBackupsRequest indicates an expected call of DescribeContinuousBackupsRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeContinuousBackupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContinuousBackupsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeContinuousBackupsRequest), arg0)
+}
+
+// DescribeContinuousBackupsWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeContinuousBackupsWithContext(arg0 context.Context, arg1 *dynamodb.DescribeContinuousBackupsInput, arg2 ...request.Option) (*dynamodb.DescribeContinuousBackupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeContinuousBackupsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeContinuousBackupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeContinuousBackupsWithContext indicates an expected call of DescribeContinuousBackupsWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeContinuousBackupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContinuousBackupsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeContinuousBackupsWithContext), varargs...)
+}
+
+// DescribeContributorInsights mocks base method
+func (m *MockDynamoDBClient) DescribeContributorInsights(arg0 *dynamodb.DescribeContributorInsightsInput) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeContributorInsights", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeContributorInsightsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeContributorInsights indicates an expected call of DescribeContributorInsights
+func (mr *MockDynamoDBClientMockRecorder) DescribeContributorInsights(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContributorInsights", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeContributorInsights), arg0)
+}
+
+// DescribeContributorInsightsRequest mocks base method
+func (m *MockDynamoDBClient) DescribeContributorInsightsRequest(arg0 *dynamodb.DescribeContributorInsightsInput) (*request.Request, *dynamodb.DescribeContributorInsightsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeContributorInsightsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeContributorInsightsOutput)
+	return ret0, ret1
+}
+
+// DescribeContributorInsightsRequest indicates an expected call of DescribeContributorInsightsRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeContributorInsightsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContributorInsightsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeContributorInsightsRequest), arg0)
+}
+
+// DescribeContributorInsightsWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeContributorInsightsWithContext(arg0 context.Context, arg1 *dynamodb.DescribeContributorInsightsInput, arg2 ...request.Option) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeContributorInsightsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeContributorInsightsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeContributorInsightsWithContext indicates an expected call of DescribeContributorInsightsWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeContributorInsightsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeContributorInsightsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeContributorInsightsWithContext), varargs...)
+}
+
+// DescribeEndpoints mocks base method
+func (m *MockDynamoDBClient) DescribeEndpoints(arg0 *dynamodb.DescribeEndpointsInput) (*dynamodb.DescribeEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEndpoints", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEndpoints indicates an expected call of DescribeEndpoints
+func (mr *MockDynamoDBClientMockRecorder) DescribeEndpoints(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpoints", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeEndpoints), arg0)
+}
+
+// DescribeEndpointsRequest mocks base method
+func (m *MockDynamoDBClient) DescribeEndpointsRequest(arg0 *dynamodb.DescribeEndpointsInput) (*request.Request, *dynamodb.DescribeEndpointsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEndpointsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeEndpointsOutput)
+	return ret0, ret1
+}
+
+// DescribeEndpointsRequest indicates an expected call of DescribeEndpointsRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeEndpointsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpointsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeEndpointsRequest), arg0)
+}
+
+// DescribeEndpointsWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeEndpointsWithContext(arg0 context.Context, arg1 *dynamodb.DescribeEndpointsInput, arg2 ...request.Option) (*dynamodb.DescribeEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEndpointsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEndpointsWithContext indicates an expected call of DescribeEndpointsWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeEndpointsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpointsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeEndpointsWithContext), varargs...)
+}
+
+// DescribeGlobalTable mocks base method
+func (m *MockDynamoDBClient) DescribeGlobalTable(arg0 *dynamodb.DescribeGlobalTableInput) (*dynamodb.DescribeGlobalTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeGlobalTable", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeGlobalTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeGlobalTable indicates an expected call of DescribeGlobalTable
+func (mr *MockDynamoDBClientMockRecorder) DescribeGlobalTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalTable", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeGlobalTable), arg0)
+}
+
+// DescribeGlobalTableRequest mocks base method
+func (m *MockDynamoDBClient) DescribeGlobalTableRequest(arg0 *dynamodb.DescribeGlobalTableInput) (*request.Request, *dynamodb.DescribeGlobalTableOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeGlobalTableRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeGlobalTableOutput)
+	return ret0, ret1
+}
+
+// DescribeGlobalTableRequest indicates an expected call of DescribeGlobalTableRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeGlobalTableRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalTableRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeGlobalTableRequest), arg0)
+}
+
+// DescribeGlobalTableSettings mocks base method
+func (m *MockDynamoDBClient) DescribeGlobalTableSettings(arg0 *dynamodb.DescribeGlobalTableSettingsInput) (*dynamodb.DescribeGlobalTableSettingsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeGlobalTableSettings", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeGlobalTableSettingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeGlobalTableSettings indicates an expected call of DescribeGlobalTableSettings
+func (mr *MockDynamoDBClientMockRecorder) DescribeGlobalTableSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalTableSettings", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeGlobalTableSettings), arg0)
+}
+
+// DescribeGlobalTableSettingsRequest mocks base method
+func (m *MockDynamoDBClient) DescribeGlobalTableSettingsRequest(arg0 *dynamodb.DescribeGlobalTableSettingsInput) (*request.Request, *dynamodb.DescribeGlobalTableSettingsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeGlobalTableSettingsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeGlobalTableSettingsOutput)
+	return ret0, ret1
+}
+
+// DescribeGlobalTableSettingsRequest indicates an expected call of DescribeGlobalTableSettingsRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeGlobalTableSettingsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalTableSettingsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeGlobalTableSettingsRequest), arg0)
+}
+
+// DescribeGlobalTableSettingsWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeGlobalTableSettingsWithContext(arg0 context.Context, arg1 *dynamodb.DescribeGlobalTableSettingsInput, arg2 ...request.Option) (*dynamodb.DescribeGlobalTableSettingsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeGlobalTableSettingsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeGlobalTableSettingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeGlobalTableSettingsWithContext indicates an expected call of DescribeGlobalTableSettingsWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeGlobalTableSettingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalTableSettingsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeGlobalTableSettingsWithContext), varargs...)
+}
+
+// DescribeGlobalTableWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeGlobalTableWithContext(arg0 context.Context, arg1 *dynamodb.DescribeGlobalTableInput, arg2 ...request.Option) (*dynamodb.DescribeGlobalTableOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeGlobalTableWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeGlobalTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeGlobalTableWithContext indicates an expected call of DescribeGlobalTableWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeGlobalTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalTableWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeGlobalTableWithContext), varargs...)
+}
+
+// DescribeLimits mocks base method
+func (m *MockDynamoDBClient) DescribeLimits(arg0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLimits", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeLimitsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLimits indicates an expected call of DescribeLimits
+func (mr *MockDynamoDBClientMockRecorder) DescribeLimits(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLimits", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeLimits), arg0)
+}
+
+// DescribeLimitsRequest mocks base method
+func (m *MockDynamoDBClient) DescribeLimitsRequest(arg0 *dynamodb.DescribeLimitsInput) (*request.Request, *dynamodb.DescribeLimitsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeLimitsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeLimitsOutput)
+	return ret0, ret1
+}
+
+// DescribeLimitsRequest indicates an expected call of DescribeLimitsRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeLimitsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLimitsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeLimitsRequest), arg0)
+}
+
+// DescribeLimitsWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeLimitsWithContext(arg0 context.Context, arg1 *dynamodb.DescribeLimitsInput, arg2 ...request.Option) (*dynamodb.DescribeLimitsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeLimitsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeLimitsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLimitsWithContext indicates an expected call of DescribeLimitsWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeLimitsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLimitsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeLimitsWithContext), varargs...)
+}
+
+// DescribeTable mocks base method
+func (m *MockDynamoDBClient) DescribeTable(arg0 *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTable", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTable indicates an expected call of DescribeTable
+func (mr *MockDynamoDBClientMockRecorder) DescribeTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTable", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTable), arg0)
+}
+
+// DescribeTableReplicaAutoScaling mocks base method
+func (m *MockDynamoDBClient) DescribeTableReplicaAutoScaling(arg0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTableReplicaAutoScaling", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTableReplicaAutoScaling indicates an expected call of DescribeTableReplicaAutoScaling
+func (mr *MockDynamoDBClientMockRecorder) DescribeTableReplicaAutoScaling(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTableReplicaAutoScaling", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTableReplicaAutoScaling), arg0)
+}
+
+// DescribeTableReplicaAutoScalingRequest mocks base method
+func (m *MockDynamoDBClient) DescribeTableReplicaAutoScalingRequest(arg0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*request.Request, *dynamodb.DescribeTableReplicaAutoScalingOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTableReplicaAutoScalingRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+	return ret0, ret1
+}
+
+// DescribeTableReplicaAutoScalingRequest indicates an expected call of DescribeTableReplicaAutoScalingRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeTableReplicaAutoScalingRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTableReplicaAutoScalingRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTableReplicaAutoScalingRequest), arg0)
+}
+
+// DescribeTableReplicaAutoScalingWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeTableReplicaAutoScalingWithContext(arg0 context.Context, arg1 *dynamodb.DescribeTableReplicaAutoScalingInput, arg2 ...request.Option) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTableReplicaAutoScalingWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTableReplicaAutoScalingWithContext indicates an expected call of DescribeTableReplicaAutoScalingWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeTableReplicaAutoScalingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTableReplicaAutoScalingWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTableReplicaAutoScalingWithContext), varargs...)
+}
+
+// DescribeTableRequest mocks base method
+func (m *MockDynamoDBClient) DescribeTableRequest(arg0 *dynamodb.DescribeTableInput) (*request.Request, *dynamodb.DescribeTableOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTableRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeTableOutput)
+	return ret0, ret1
+}
+
+// DescribeTableRequest indicates an expected call of DescribeTableRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeTableRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTableRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTableRequest), arg0)
+}
+
+// DescribeTableWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeTableWithContext(arg0 context.Context, arg1 *dynamodb.DescribeTableInput, arg2 ...request.Option) (*dynamodb.DescribeTableOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTableWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTableWithContext indicates an expected call of DescribeTableWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTableWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTableWithContext), varargs...)
+}
+
+// DescribeTimeToLive mocks base method
+func (m *MockDynamoDBClient) DescribeTimeToLive(arg0 *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTimeToLive", arg0)
+	ret0, _ := ret[0].(*dynamodb.DescribeTimeToLiveOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTimeToLive indicates an expected call of DescribeTimeToLive
+func (mr *MockDynamoDBClientMockRecorder) DescribeTimeToLive(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTimeToLive", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTimeToLive), arg0)
+}
+
+// DescribeTimeToLiveRequest mocks base method
+func (m *MockDynamoDBClient) DescribeTimeToLiveRequest(arg0 *dynamodb.DescribeTimeToLiveInput) (*request.Request, *dynamodb.DescribeTimeToLiveOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTimeToLiveRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.DescribeTimeToLiveOutput)
+	return ret0, ret1
+}
+
+// DescribeTimeToLiveRequest indicates an expected call of DescribeTimeToLiveRequest
+func (mr *MockDynamoDBClientMockRecorder) DescribeTimeToLiveRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTimeToLiveRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTimeToLiveRequest), arg0)
+}
+
+// DescribeTimeToLiveWithContext mocks base method
+func (m *MockDynamoDBClient) DescribeTimeToLiveWithContext(arg0 context.Context, arg1 *dynamodb.DescribeTimeToLiveInput, arg2 ...request.Option) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTimeToLiveWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.DescribeTimeToLiveOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTimeToLiveWithContext indicates an expected call of DescribeTimeToLiveWithContext
+func (mr *MockDynamoDBClientMockRecorder) DescribeTimeToLiveWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTimeToLiveWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).DescribeTimeToLiveWithContext), varargs...)
+}
+
+// GetItem mocks base method
+func (m *MockDynamoDBClient) GetItem(arg0 *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", arg0)
+	ret0, _ := ret[0].(*dynamodb.GetItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem
+func (mr *MockDynamoDBClientMockRecorder) GetItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockDynamoDBClient)(nil).GetItem), arg0)
+}
+
+// GetItemRequest mocks base method
+func (m *MockDynamoDBClient) GetItemRequest(arg0 *dynamodb.GetItemInput) (*request.Request, *dynamodb.GetItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.GetItemOutput)
+	return ret0, ret1
+}
+
+// GetItemRequest indicates an expected call of GetItemRequest
+func (mr *MockDynamoDBClientMockRecorder) GetItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).GetItemRequest), arg0)
+}
+
+// GetItemWithContext mocks base method
+func (m *MockDynamoDBClient) GetItemWithContext(arg0 context.Context, arg1 *dynamodb.GetItemInput, arg2 ...request.Option) (*dynamodb.GetItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetItemWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.GetItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemWithContext indicates an expected call of GetItemWithContext
+func (mr *MockDynamoDBClientMockRecorder) GetItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).GetItemWithContext), varargs...)
+}
+
+// ListBackups mocks base method
+func (m *MockDynamoDBClient) ListBackups(arg0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackups", arg0)
+	ret0, _ := ret[0].(*dynamodb.ListBackupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackups indicates an expected call of ListBackups
+func (mr *MockDynamoDBClientMockRecorder) ListBackups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackups", reflect.TypeOf((*MockDynamoDBClient)(nil).ListBackups), arg0)
+}
+
+// ListBackupsRequest mocks base method
+func (m *MockDynamoDBClient) ListBackupsRequest(arg0 *dynamodb.ListBackupsInput) (*request.Request, *dynamodb.ListBackupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.ListBackupsOutput)
+	return ret0, ret1
+}
+
+// ListBackupsRequest indicates an expected call of ListBackupsRequest
+func (mr *MockDynamoDBClientMockRecorder) ListBackupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).ListBackupsRequest), arg0)
+}
+
+// ListBackupsWithContext mocks base method
+func (m *MockDynamoDBClient) ListBackupsWithContext(arg0 context.Context, arg1 *dynamodb.ListBackupsInput, arg2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.ListBackupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupsWithContext indicates an expected call of ListBackupsWithContext
+func (mr *MockDynamoDBClientMockRecorder) ListBackupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ListBackupsWithContext), varargs...)
+}
+
+// ListContributorInsights mocks base method
+func (m *MockDynamoDBClient) ListContributorInsights(arg0 *dynamodb.ListContributorInsightsInput) (*dynamodb.ListContributorInsightsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContributorInsights", arg0)
+	ret0, _ := ret[0].(*dynamodb.ListContributorInsightsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContributorInsights indicates an expected call of ListContributorInsights
+func (mr *MockDynamoDBClientMockRecorder) ListContributorInsights(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributorInsights", reflect.TypeOf((*MockDynamoDBClient)(nil).ListContributorInsights), arg0)
+}
+
+// ListContributorInsightsPages mocks base method
+func (m *MockDynamoDBClient) ListContributorInsightsPages(arg0 *dynamodb.ListContributorInsightsInput, arg1 func(*dynamodb.ListContributorInsightsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContributorInsightsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListContributorInsightsPages indicates an expected call of ListContributorInsightsPages
+func (mr *MockDynamoDBClientMockRecorder) ListContributorInsightsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributorInsightsPages", reflect.TypeOf((*MockDynamoDBClient)(nil).ListContributorInsightsPages), arg0, arg1)
+}
+
+// ListContributorInsightsPagesWithContext mocks base method
+func (m *MockDynamoDBClient) ListContributorInsightsPagesWithContext(arg0 context.Context, arg1 *dynamodb.ListContributorInsightsInput, arg2 func(*dynamodb.ListContributorInsightsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListContributorInsightsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListContributorInsightsPagesWithContext indicates an expected call of ListContributorInsightsPagesWithContext
+func (mr *MockDynamoDBClientMockRecorder) ListContributorInsightsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributorInsightsPagesWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ListContributorInsightsPagesWithContext), varargs...)
+}
+
+// ListContributorInsightsRequest mocks base method
+func (m *MockDynamoDBClient) ListContributorInsightsRequest(arg0 *dynamodb.ListContributorInsightsInput) (*request.Request, *dynamodb.ListContributorInsightsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContributorInsightsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.ListContributorInsightsOutput)
+	return ret0, ret1
+}
+
+// ListContributorInsightsRequest indicates an expected call of ListContributorInsightsRequest
+func (mr *MockDynamoDBClientMockRecorder) ListContributorInsightsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributorInsightsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).ListContributorInsightsRequest), arg0)
+}
+
+// ListContributorInsightsWithContext mocks base method
+func (m *MockDynamoDBClient) ListContributorInsightsWithContext(arg0 context.Context, arg1 *dynamodb.ListContributorInsightsInput, arg2 ...request.Option) (*dynamodb.ListContributorInsightsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListContributorInsightsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.ListContributorInsightsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContributorInsightsWithContext indicates an expected call of ListContributorInsightsWithContext
+func (mr *MockDynamoDBClientMockRecorder) ListContributorInsightsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributorInsightsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ListContributorInsightsWithContext), varargs...)
+}
+
+// ListGlobalTables mocks base method
+func (m *MockDynamoDBClient) ListGlobalTables(arg0 *dynamodb.ListGlobalTablesInput) (*dynamodb.ListGlobalTablesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGlobalTables", arg0)
+	ret0, _ := ret[0].(*dynamodb.ListGlobalTablesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGlobalTables indicates an expected call of ListGlobalTables
+func (mr *MockDynamoDBClientMockRecorder) ListGlobalTables(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalTables", reflect.TypeOf((*MockDynamoDBClient)(nil).ListGlobalTables), arg0)
+}
+
+// ListGlobalTablesRequest mocks base method
+func (m *MockDynamoDBClient) ListGlobalTablesRequest(arg0 *dynamodb.ListGlobalTablesInput) (*request.Request, *dynamodb.ListGlobalTablesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGlobalTablesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.ListGlobalTablesOutput)
+	return ret0, ret1
+}
+
+// ListGlobalTablesRequest indicates an expected call of ListGlobalTablesRequest
+func (mr *MockDynamoDBClientMockRecorder) ListGlobalTablesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalTablesRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).ListGlobalTablesRequest), arg0)
+}
+
+// ListGlobalTablesWithContext mocks base method
+func (m *MockDynamoDBClient) ListGlobalTablesWithContext(arg0 context.Context, arg1 *dynamodb.ListGlobalTablesInput, arg2 ...request.Option) (*dynamodb.ListGlobalTablesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGlobalTablesWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.ListGlobalTablesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGlobalTablesWithContext indicates an expected call of ListGlobalTablesWithContext
+func (mr *MockDynamoDBClientMockRecorder) ListGlobalTablesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalTablesWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ListGlobalTablesWithContext), varargs...)
+}
+
+// ListTables mocks base method
+func (m *MockDynamoDBClient) ListTables(arg0 *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTables", arg0)
+	ret0, _ := ret[0].(*dynamodb.ListTablesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTables indicates an expected call of ListTables
+func (mr *MockDynamoDBClientMockRecorder) ListTables(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTables", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTables), arg0)
+}
+
+// ListTablesPages mocks base method
+func (m *MockDynamoDBClient) ListTablesPages(arg0 *dynamodb.ListTablesInput, arg1 func(*dynamodb.ListTablesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTablesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListTablesPages indicates an expected call of ListTablesPages
+func (mr *MockDynamoDBClientMockRecorder) ListTablesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTablesPages", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTablesPages), arg0, arg1)
+}
+
+// ListTablesPagesWithContext mocks base method
+func (m *MockDynamoDBClient) ListTablesPagesWithContext(arg0 context.Context, arg1 *dynamodb.ListTablesInput, arg2 func(*dynamodb.ListTablesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTablesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListTablesPagesWithContext indicates an expected call of ListTablesPagesWithContext
+func (mr *MockDynamoDBClientMockRecorder) ListTablesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTablesPagesWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTablesPagesWithContext), varargs...)
+}
+
+// ListTablesRequest mocks base method
+func (m *MockDynamoDBClient) ListTablesRequest(arg0 *dynamodb.ListTablesInput) (*request.Request, *dynamodb.ListTablesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTablesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.ListTablesOutput)
+	return ret0, ret1
+}
+
+// ListTablesRequest indicates an expected call of ListTablesRequest
+func (mr *MockDynamoDBClientMockRecorder) ListTablesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTablesRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTablesRequest), arg0)
+}
+
+// ListTablesWithContext mocks base method
+func (m *MockDynamoDBClient) ListTablesWithContext(arg0 context.Context, arg1 *dynamodb.ListTablesInput, arg2 ...request.Option) (*dynamodb.ListTablesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTablesWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.ListTablesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTablesWithContext indicates an expected call of ListTablesWithContext
+func (mr *MockDynamoDBClientMockRecorder) ListTablesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTablesWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTablesWithContext), varargs...)
+}
+
+// ListTagsOfResource mocks base method
+func (m *MockDynamoDBClient) ListTagsOfResource(arg0 *dynamodb.ListTagsOfResourceInput) (*dynamodb.ListTagsOfResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsOfResource", arg0)
+	ret0, _ := ret[0].(*dynamodb.ListTagsOfResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsOfResource indicates an expected call of ListTagsOfResource
+func (mr *MockDynamoDBClientMockRecorder) ListTagsOfResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsOfResource", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTagsOfResource), arg0)
+}
+
+// ListTagsOfResourceRequest mocks base method
+func (m *MockDynamoDBClient) ListTagsOfResourceRequest(arg0 *dynamodb.ListTagsOfResourceInput) (*request.Request, *dynamodb.ListTagsOfResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsOfResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.ListTagsOfResourceOutput)
+	return ret0, ret1
+}
+
+// ListTagsOfResourceRequest indicates an expected call of ListTagsOfResourceRequest
+func (mr *MockDynamoDBClientMockRecorder) ListTagsOfResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsOfResourceRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTagsOfResourceRequest), arg0)
+}
+
+// ListTagsOfResourceWithContext mocks base method
+func (m *MockDynamoDBClient) ListTagsOfResourceWithContext(arg0 context.Context, arg1 *dynamodb.ListTagsOfResourceInput, arg2 ...request.Option) (*dynamodb.ListTagsOfResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsOfResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.ListTagsOfResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsOfResourceWithContext indicates an expected call of ListTagsOfResourceWithContext
+func (mr *MockDynamoDBClientMockRecorder) ListTagsOfResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsOfResourceWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ListTagsOfResourceWithContext), varargs...)
+}
+
+// PutItem mocks base method
+func (m *MockDynamoDBClient) PutItem(arg0 *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutItem", arg0)
+	ret0, _ := ret[0].(*dynamodb.PutItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutItem indicates an expected call of PutItem
+func (mr *MockDynamoDBClientMockRecorder) PutItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutItem", reflect.TypeOf((*MockDynamoDBClient)(nil).PutItem), arg0)
+}
+
+// PutItemRequest mocks base method
+func (m *MockDynamoDBClient) PutItemRequest(arg0 *dynamodb.PutItemInput) (*request.Request, *dynamodb.PutItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.PutItemOutput)
+	return ret0, ret1
+}
+
+// PutItemRequest indicates an expected call of PutItemRequest
+func (mr *MockDynamoDBClientMockRecorder) PutItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutItemRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).PutItemRequest), arg0)
+}
+
+// PutItemWithContext mocks base method
+func (m *MockDynamoDBClient) PutItemWithContext(arg0 context.Context, arg1 *dynamodb.PutItemInput, arg2 ...request.Option) (*dynamodb.PutItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutItemWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.PutItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutItemWithContext indicates an expected call of PutItemWithContext
+func (mr *MockDynamoDBClientMockRecorder) PutItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutItemWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).PutItemWithContext), varargs...)
+}
+
+// Query mocks base method
+func (m *MockDynamoDBClient) Query(arg0 *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0)
+	ret0, _ := ret[0].(*dynamodb.QueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query
+func (mr *MockDynamoDBClientMockRecorder) Query(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockDynamoDBClient)(nil).Query), arg0)
+}
+
+// QueryPages mocks base method
+func (m *MockDynamoDBClient) QueryPages(arg0 *dynamodb.QueryInput, arg1 func(*dynamodb.QueryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueryPages indicates an expected call of QueryPages
+func (mr *MockDynamoDBClientMockRecorder) QueryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryPages", reflect.TypeOf((*MockDynamoDBClient)(nil).QueryPages), arg0, arg1)
+}
+
+// QueryPagesWithContext mocks base method
+func (m *MockDynamoDBClient) QueryPagesWithContext(arg0 context.Context, arg1 *dynamodb.QueryInput, arg2 func(*dynamodb.QueryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueryPagesWithContext indicates an expected call of QueryPagesWithContext
+func (mr *MockDynamoDBClientMockRecorder) QueryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryPagesWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).QueryPagesWithContext), varargs...)
+}
+
+// QueryRequest mocks base method
+func (m *MockDynamoDBClient) QueryRequest(arg0 *dynamodb.QueryInput) (*request.Request, *dynamodb.QueryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.QueryOutput)
+	return ret0, ret1
+}
+
+// QueryRequest indicates an expected call of QueryRequest
+func (mr *MockDynamoDBClientMockRecorder) QueryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).QueryRequest), arg0)
+}
+
+// QueryWithContext mocks base method
+func (m *MockDynamoDBClient) QueryWithContext(arg0 context.Context, arg1 *dynamodb.QueryInput, arg2 ...request.Option) (*dynamodb.QueryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.QueryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryWithContext indicates an expected call of QueryWithContext
+func (mr *MockDynamoDBClientMockRecorder) QueryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).QueryWithContext), varargs...)
+}
+
+// RestoreTableFromBackup mocks base method
+func (m *MockDynamoDBClient) RestoreTableFromBackup(arg0 *dynamodb.RestoreTableFromBackupInput) (*dynamodb.RestoreTableFromBackupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTableFromBackup", arg0)
+	ret0, _ := ret[0].(*dynamodb.RestoreTableFromBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTableFromBackup indicates an expected call of RestoreTableFromBackup
+func (mr *MockDynamoDBClientMockRecorder) RestoreTableFromBackup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTableFromBackup", reflect.TypeOf((*MockDynamoDBClient)(nil).RestoreTableFromBackup), arg0)
+}
+
+// RestoreTableFromBackupRequest mocks base method
+func (m *MockDynamoDBClient) RestoreTableFromBackupRequest(arg0 *dynamodb.RestoreTableFromBackupInput) (*request.Request, *dynamodb.RestoreTableFromBackupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTableFromBackupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.RestoreTableFromBackupOutput)
+	return ret0, ret1
+}
+
+// RestoreTableFromBackupRequest indicates an expected call of RestoreTableFromBackupRequest
+func (mr *MockDynamoDBClientMockRecorder) RestoreTableFromBackupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTableFromBackupRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).RestoreTableFromBackupRequest), arg0)
+}
+
+// RestoreTableFromBackupWithContext mocks base method
+func (m *MockDynamoDBClient) RestoreTableFromBackupWithContext(arg0 context.Context, arg1 *dynamodb.RestoreTableFromBackupInput, arg2 ...request.Option) (*dynamodb.RestoreTableFromBackupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreTableFromBackupWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.RestoreTableFromBackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTableFromBackupWithContext indicates an expected call of RestoreTableFromBackupWithContext
+func (mr *MockDynamoDBClientMockRecorder) RestoreTableFromBackupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTableFromBackupWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).RestoreTableFromBackupWithContext), varargs...)
+}
+
+// RestoreTableToPointInTime mocks base method
+func (m *MockDynamoDBClient) RestoreTableToPointInTime(arg0 *dynamodb.RestoreTableToPointInTimeInput) (*dynamodb.RestoreTableToPointInTimeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTableToPointInTime", arg0)
+	ret0, _ := ret[0].(*dynamodb.RestoreTableToPointInTimeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTableToPointInTime indicates an expected call of RestoreTableToPointInTime
+func (mr *MockDynamoDBClientMockRecorder) RestoreTableToPointInTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTableToPointInTime", reflect.TypeOf((*MockDynamoDBClient)(nil).RestoreTableToPointInTime), arg0)
+}
+
+// RestoreTableToPointInTimeRequest mocks base method
+func (m *MockDynamoDBClient) RestoreTableToPointInTimeRequest(arg0 *dynamodb.RestoreTableToPointInTimeInput) (*request.Request, *dynamodb.RestoreTableToPointInTimeOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTableToPointInTimeRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.RestoreTableToPointInTimeOutput)
+	return ret0, ret1
+}
+
+// RestoreTableToPointInTimeRequest indicates an expected call of RestoreTableToPointInTimeRequest
+func (mr *MockDynamoDBClientMockRecorder) RestoreTableToPointInTimeRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTableToPointInTimeRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).RestoreTableToPointInTimeRequest), arg0)
+}
+
+// RestoreTableToPointInTimeWithContext mocks base method
+func (m *MockDynamoDBClient) RestoreTableToPointInTimeWithContext(arg0 context.Context, arg1 *dynamodb.RestoreTableToPointInTimeInput, arg2 ...request.Option) (*dynamodb.RestoreTableToPointInTimeOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreTableToPointInTimeWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.RestoreTableToPointInTimeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTableToPointInTimeWithContext indicates an expected call of RestoreTableToPointInTimeWithContext
+func (mr *MockDynamoDBClientMockRecorder) RestoreTableToPointInTimeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTableToPointInTimeWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).RestoreTableToPointInTimeWithContext), varargs...)
+}
+
+// Scan mocks base method
+func (m *MockDynamoDBClient) Scan(arg0 *dynamodb.ScanInput) (*dynamodb.ScanOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", arg0)
+	ret0, _ := ret[0].(*dynamodb.ScanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scan indicates an expected call of Scan
+func (mr *MockDynamoDBClientMockRecorder) Scan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockDynamoDBClient)(nil).Scan), arg0)
+}
+
+// ScanPages mocks base method
+func (m *MockDynamoDBClient) ScanPages(arg0 *dynamodb.ScanInput, arg1 func(*dynamodb.ScanOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScanPages indicates an expected call of ScanPages
+func (mr *MockDynamoDBClientMockRecorder) ScanPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPages", reflect.TypeOf((*MockDynamoDBClient)(nil).ScanPages), arg0, arg1)
+}
+
+// ScanPagesWithContext mocks base method
+func (m *MockDynamoDBClient) ScanPagesWithContext(arg0 context.Context, arg1 *dynamodb.ScanInput, arg2 func(*dynamodb.ScanOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScanPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScanPagesWithContext indicates an expected call of ScanPagesWithContext
+func (mr *MockDynamoDBClientMockRecorder) ScanPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPagesWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ScanPagesWithContext), varargs...)
+}
+
+// ScanRequest mocks base method
+func (m *MockDynamoDBClient) ScanRequest(arg0 *dynamodb.ScanInput) (*request.Request, *dynamodb.ScanOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.ScanOutput)
+	return ret0, ret1
+}
+
+// ScanRequest indicates an expected call of ScanRequest
+func (mr *MockDynamoDBClientMockRecorder) ScanRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).ScanRequest), arg0)
+}
+
+// ScanWithContext mocks base method
+func (m *MockDynamoDBClient) ScanWithContext(arg0 context.Context, arg1 *dynamodb.ScanInput, arg2 ...request.Option) (*dynamodb.ScanOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScanWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.ScanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanWithContext indicates an expected call of ScanWithContext
+func (mr *MockDynamoDBClientMockRecorder) ScanWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).ScanWithContext), varargs...)
+}
+
+// TagResource mocks base method
+func (m *MockDynamoDBClient) TagResource(arg0 *dynamodb.TagResourceInput) (*dynamodb.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResource", arg0)
+	ret0, _ := ret[0].(*dynamodb.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResource indicates an expected call of TagResource
+func (mr *MockDynamoDBClientMockRecorder) TagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockDynamoDBClient)(nil).TagResource), arg0)
+}
+
+// TagResourceRequest mocks base method
+func (m *MockDynamoDBClient) TagResourceRequest(arg0 *dynamodb.TagResourceInput) (*request.Request, *dynamodb.TagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.TagResourceOutput)
+	return ret0, ret1
+}
+
+// TagResourceRequest indicates an expected call of TagResourceRequest
+func (mr *MockDynamoDBClientMockRecorder) TagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).TagResourceRequest), arg0)
+}
+
+// TagResourceWithContext mocks base method
+func (m *MockDynamoDBClient) TagResourceWithContext(arg0 context.Context, arg1 *dynamodb.TagResourceInput, arg2 ...request.Option) (*dynamodb.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResourceWithContext indicates an expected call of TagResourceWithContext
+func (mr *MockDynamoDBClientMockRecorder) TagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).TagResourceWithContext), varargs...)
+}
+
+// TransactGetItems mocks base method
+func (m *MockDynamoDBClient) TransactGetItems(arg0 *dynamodb.TransactGetItemsInput) (*dynamodb.TransactGetItemsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactGetItems", arg0)
+	ret0, _ := ret[0].(*dynamodb.TransactGetItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactGetItems indicates an expected call of TransactGetItems
+func (mr *MockDynamoDBClientMockRecorder) TransactGetItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactGetItems", reflect.TypeOf((*MockDynamoDBClient)(nil).TransactGetItems), arg0)
+}
+
+// TransactGetItemsRequest mocks base method
+func (m *MockDynamoDBClient) TransactGetItemsRequest(arg0 *dynamodb.TransactGetItemsInput) (*request.Request, *dynamodb.TransactGetItemsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactGetItemsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.TransactGetItemsOutput)
+	return ret0, ret1
+}
+
+// TransactGetItemsRequest indicates an expected call of TransactGetItemsRequest
+func (mr *MockDynamoDBClientMockRecorder) TransactGetItemsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactGetItemsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).TransactGetItemsRequest), arg0)
+}
+
+// TransactGetItemsWithContext mocks base method
+func (m *MockDynamoDBClient) TransactGetItemsWithContext(arg0 context.Context, arg1 *dynamodb.TransactGetItemsInput, arg2 ...request.Option) (*dynamodb.TransactGetItemsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransactGetItemsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.TransactGetItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactGetItemsWithContext indicates an expected call of TransactGetItemsWithContext
+func (mr *MockDynamoDBClientMockRecorder) TransactGetItemsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactGetItemsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).TransactGetItemsWithContext), varargs...)
+}
+
+// TransactWriteItems mocks base method
+func (m *MockDynamoDBClient) TransactWriteItems(arg0 *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactWriteItems", arg0)
+	ret0, _ := ret[0].(*dynamodb.TransactWriteItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactWriteItems indicates an expected call of TransactWriteItems
+func (mr *MockDynamoDBClientMockRecorder) TransactWriteItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactWriteItems", reflect.TypeOf((*MockDynamoDBClient)(nil).TransactWriteItems), arg0)
+}
+
+// TransactWriteItemsRequest mocks base method
+func (m *MockDynamoDBClient) TransactWriteItemsRequest(arg0 *dynamodb.TransactWriteItemsInput) (*request.Request, *dynamodb.TransactWriteItemsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactWriteItemsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.TransactWriteItemsOutput)
+	return ret0, ret1
+}
+
+// TransactWriteItemsRequest indicates an expected call of TransactWriteItemsRequest
+func (mr *MockDynamoDBClientMockRecorder) TransactWriteItemsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactWriteItemsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).TransactWriteItemsRequest), arg0)
+}
+
+// TransactWriteItemsWithContext mocks base method
+func (m *MockDynamoDBClient) TransactWriteItemsWithContext(arg0 context.Context, arg1 *dynamodb.TransactWriteItemsInput, arg2 ...request.Option) (*dynamodb.TransactWriteItemsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransactWriteItemsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.TransactWriteItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactWriteItemsWithContext indicates an expected call of TransactWriteItemsWithContext
+func (mr *MockDynamoDBClientMockRecorder) TransactWriteItemsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactWriteItemsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).TransactWriteItemsWithContext), varargs...)
+}
+
+// UntagResource mocks base method
+func (m *MockDynamoDBClient) UntagResource(arg0 *dynamodb.UntagResourceInput) (*dynamodb.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResource", arg0)
+	ret0, _ := ret[0].(*dynamodb.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResource indicates an expected call of UntagResource
+func (mr *MockDynamoDBClientMockRecorder) UntagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockDynamoDBClient)(nil).UntagResource), arg0)
+}
+
+// UntagResourceRequest mocks base method
+func (m *MockDynamoDBClient) UntagResourceRequest(arg0 *dynamodb.UntagResourceInput) (*request.Request, *dynamodb.UntagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UntagResourceOutput)
+	return ret0, ret1
+}
+
+// UntagResourceRequest indicates an expected call of UntagResourceRequest
+func (mr *MockDynamoDBClientMockRecorder) UntagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UntagResourceRequest), arg0)
+}
+
+// UntagResourceWithContext mocks base method
+func (m *MockDynamoDBClient) UntagResourceWithContext(arg0 context.Context, arg1 *dynamodb.UntagResourceInput, arg2 ...request.Option) (*dynamodb.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UntagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResourceWithContext indicates an expected call of UntagResourceWithContext
+func (mr *MockDynamoDBClientMockRecorder) UntagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UntagResourceWithContext), varargs...)
+}
+
+// UpdateContinuousBackups mocks base method
+func (m *MockDynamoDBClient) UpdateContinuousBackups(arg0 *dynamodb.UpdateContinuousBackupsInput) (*dynamodb.UpdateContinuousBackupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContinuousBackups", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateContinuousBackupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContinuousBackups indicates an expected call of UpdateContinuousBackups
+func (mr *MockDynamoDBClientMockRecorder) UpdateContinuousBackups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContinuousBackups", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateContinuousBackups), arg0)
+}
+
+// UpdateContinuousBackupsRequest mocks base method
+func (m *MockDynamoDBClient) UpdateContinuousBackupsRequest(arg0 *dynamodb.UpdateContinuousBackupsInput) (*request.Request, *dynamodb.UpdateContinuousBackupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContinuousBackupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateContinuousBackupsOutput)
+	return ret0, ret1
+}
+
+// UpdateContinuousBackupsRequest indicates an expected call of UpdateContinuousBackupsRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateContinuousBackupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContinuousBackupsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateContinuousBackupsRequest), arg0)
+}
+
+// UpdateContinuousBackupsWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateContinuousBackupsWithContext(arg0 context.Context, arg1 *dynamodb.UpdateContinuousBackupsInput, arg2 ...request.Option) (*dynamodb.UpdateContinuousBackupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateContinuousBackupsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateContinuousBackupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContinuousBackupsWithContext indicates an expected call of UpdateContinuousBackupsWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateContinuousBackupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContinuousBackupsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateContinuousBackupsWithContext), varargs...)
+}
+
+// UpdateContributorInsights mocks base method
+func (m *MockDynamoDBClient) UpdateContributorInsights(arg0 *dynamodb.UpdateContributorInsightsInput) (*dynamodb.UpdateContributorInsightsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContributorInsights", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateContributorInsightsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContributorInsights indicates an expected call of UpdateContributorInsights
+func (mr *MockDynamoDBClientMockRecorder) UpdateContributorInsights(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContributorInsights", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateContributorInsights), arg0)
+}
+
+// UpdateContributorInsightsRequest mocks base method
+func (m *MockDynamoDBClient) UpdateContributorInsightsRequest(arg0 *dynamodb.UpdateContributorInsightsInput) (*request.Request, *dynamodb.UpdateContributorInsightsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContributorInsightsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateContributorInsightsOutput)
+	return ret0, ret1
+}
+
+// UpdateContributorInsightsRequest indicates an expected call of UpdateContributorInsightsRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateContributorInsightsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContributorInsightsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateContributorInsightsRequest), arg0)
+}
+
+// UpdateContributorInsightsWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateContributorInsightsWithContext(arg0 context.Context, arg1 *dynamodb.UpdateContributorInsightsInput, arg2 ...request.Option) (*dynamodb.UpdateContributorInsightsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateContributorInsightsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateContributorInsightsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContributorInsightsWithContext indicates an expected call of UpdateContributorInsightsWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateContributorInsightsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContributorInsightsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateContributorInsightsWithContext), varargs...)
+}
+
+// UpdateGlobalTable mocks base method
+func (m *MockDynamoDBClient) UpdateGlobalTable(arg0 *dynamodb.UpdateGlobalTableInput) (*dynamodb.UpdateGlobalTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGlobalTable", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateGlobalTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGlobalTable indicates an expected call of UpdateGlobalTable
+func (mr *MockDynamoDBClientMockRecorder) UpdateGlobalTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalTable", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateGlobalTable), arg0)
+}
+
+// UpdateGlobalTableRequest mocks base method
+func (m *MockDynamoDBClient) UpdateGlobalTableRequest(arg0 *dynamodb.UpdateGlobalTableInput) (*request.Request, *dynamodb.UpdateGlobalTableOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGlobalTableRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateGlobalTableOutput)
+	return ret0, ret1
+}
+
+// UpdateGlobalTableRequest indicates an expected call of UpdateGlobalTableRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateGlobalTableRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalTableRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateGlobalTableRequest), arg0)
+}
+
+// UpdateGlobalTableSettings mocks base method
+func (m *MockDynamoDBClient) UpdateGlobalTableSettings(arg0 *dynamodb.UpdateGlobalTableSettingsInput) (*dynamodb.UpdateGlobalTableSettingsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGlobalTableSettings", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateGlobalTableSettingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGlobalTableSettings indicates an expected call of UpdateGlobalTableSettings
+func (mr *MockDynamoDBClientMockRecorder) UpdateGlobalTableSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalTableSettings", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateGlobalTableSettings), arg0)
+}
+
+// UpdateGlobalTableSettingsRequest mocks base method
+func (m *MockDynamoDBClient) UpdateGlobalTableSettingsRequest(arg0 *dynamodb.UpdateGlobalTableSettingsInput) (*request.Request, *dynamodb.UpdateGlobalTableSettingsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGlobalTableSettingsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateGlobalTableSettingsOutput)
+	return ret0, ret1
+}
+
+// UpdateGlobalTableSettingsRequest indicates an expected call of UpdateGlobalTableSettingsRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateGlobalTableSettingsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalTableSettingsRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateGlobalTableSettingsRequest), arg0)
+}
+
+// UpdateGlobalTableSettingsWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateGlobalTableSettingsWithContext(arg0 context.Context, arg1 *dynamodb.UpdateGlobalTableSettingsInput, arg2 ...request.Option) (*dynamodb.UpdateGlobalTableSettingsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGlobalTableSettingsWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateGlobalTableSettingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGlobalTableSettingsWithContext indicates an expected call of UpdateGlobalTableSettingsWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateGlobalTableSettingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalTableSettingsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateGlobalTableSettingsWithContext), varargs...)
+}
+
+// UpdateGlobalTableWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateGlobalTableWithContext(arg0 context.Context, arg1 *dynamodb.UpdateGlobalTableInput, arg2 ...request.Option) (*dynamodb.UpdateGlobalTableOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGlobalTableWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateGlobalTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGlobalTableWithContext indicates an expected call of UpdateGlobalTableWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateGlobalTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalTableWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateGlobalTableWithContext), varargs...)
+}
+
+// UpdateItem mocks base method
+func (m *MockDynamoDBClient) UpdateItem(arg0 *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItem indicates an expected call of UpdateItem
+func (mr *MockDynamoDBClientMockRecorder) UpdateItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateItem), arg0)
+}
+
+// UpdateItemRequest mocks base method
+func (m *MockDynamoDBClient) UpdateItemRequest(arg0 *dynamodb.UpdateItemInput) (*request.Request, *dynamodb.UpdateItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateItemOutput)
+	return ret0, ret1
+}
+
+// UpdateItemRequest indicates an expected call of UpdateItemRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateItemRequest), arg0)
+}
+
+// UpdateItemWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateItemWithContext(arg0 context.Context, arg1 *dynamodb.UpdateItemInput, arg2 ...request.Option) (*dynamodb.UpdateItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateItemWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateItemWithContext indicates an expected call of UpdateItemWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateItemWithContext), varargs...)
+}
+
+// UpdateTable mocks base method
+func (m *MockDynamoDBClient) UpdateTable(arg0 *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTable", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTable indicates an expected call of UpdateTable
+func (mr *MockDynamoDBClientMockRecorder) UpdateTable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTable", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTable), arg0)
+}
+
+// UpdateTableReplicaAutoScaling mocks base method
+func (m *MockDynamoDBClient) UpdateTableReplicaAutoScaling(arg0 *dynamodb.UpdateTableReplicaAutoScalingInput) (*dynamodb.UpdateTableReplicaAutoScalingOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTableReplicaAutoScaling", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTableReplicaAutoScaling indicates an expected call of UpdateTableReplicaAutoScaling
+func (mr *MockDynamoDBClientMockRecorder) UpdateTableReplicaAutoScaling(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTableReplicaAutoScaling", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTableReplicaAutoScaling), arg0)
+}
+
+// UpdateTableReplicaAutoScalingRequest mocks base method
+func (m *MockDynamoDBClient) UpdateTableReplicaAutoScalingRequest(arg0 *dynamodb.UpdateTableReplicaAutoScalingInput) (*request.Request, *dynamodb.UpdateTableReplicaAutoScalingOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTableReplicaAutoScalingRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+	return ret0, ret1
+}
+
+// UpdateTableReplicaAutoScalingRequest indicates an expected call of UpdateTableReplicaAutoScalingRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateTableReplicaAutoScalingRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTableReplicaAutoScalingRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTableReplicaAutoScalingRequest), arg0)
+}
+
+// UpdateTableReplicaAutoScalingWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateTableReplicaAutoScalingWithContext(arg0 context.Context, arg1 *dynamodb.UpdateTableReplicaAutoScalingInput, arg2 ...request.Option) (*dynamodb.UpdateTableReplicaAutoScalingOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTableReplicaAutoScalingWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateTableReplicaAutoScalingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTableReplicaAutoScalingWithContext indicates an expected call of UpdateTableReplicaAutoScalingWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateTableReplicaAutoScalingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTableReplicaAutoScalingWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTableReplicaAutoScalingWithContext), varargs...)
+}
+
+// UpdateTableRequest mocks base method
+func (m *MockDynamoDBClient) UpdateTableRequest(arg0 *dynamodb.UpdateTableInput) (*request.Request, *dynamodb.UpdateTableOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTableRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateTableOutput)
+	return ret0, ret1
+}
+
+// UpdateTableRequest indicates an expected call of UpdateTableRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateTableRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTableRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTableRequest), arg0)
+}
+
+// UpdateTableWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateTableWithContext(arg0 context.Context, arg1 *dynamodb.UpdateTableInput, arg2 ...request.Option) (*dynamodb.UpdateTableOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTableWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTableWithContext indicates an expected call of UpdateTableWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTableWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTableWithContext), varargs...)
+}
+
+// UpdateTimeToLive mocks base method
+func (m *MockDynamoDBClient) UpdateTimeToLive(arg0 *dynamodb.UpdateTimeToLiveInput) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeToLive", arg0)
+	ret0, _ := ret[0].(*dynamodb.UpdateTimeToLiveOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimeToLive indicates an expected call of UpdateTimeToLive
+func (mr *MockDynamoDBClientMockRecorder) UpdateTimeToLive(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeToLive", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTimeToLive), arg0)
+}
+
+// UpdateTimeToLiveRequest mocks base method
+func (m *MockDynamoDBClient) UpdateTimeToLiveRequest(arg0 *dynamodb.UpdateTimeToLiveInput) (*request.Request, *dynamodb.UpdateTimeToLiveOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeToLiveRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*dynamodb.UpdateTimeToLiveOutput)
+	return ret0, ret1
+}
+
+// UpdateTimeToLiveRequest indicates an expected call of UpdateTimeToLiveRequest
+func (mr *MockDynamoDBClientMockRecorder) UpdateTimeToLiveRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeToLiveRequest", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTimeToLiveRequest), arg0)
+}
+
+// UpdateTimeToLiveWithContext mocks base method
+func (m *MockDynamoDBClient) UpdateTimeToLiveWithContext(arg0 context.Context, arg1 *dynamodb.UpdateTimeToLiveInput, arg2 ...request.Option) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTimeToLiveWithContext", varargs...)
+	ret0, _ := ret[0].(*dynamodb.UpdateTimeToLiveOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimeToLiveWithContext indicates an expected call of UpdateTimeToLiveWithContext
+func (mr *MockDynamoDBClientMockRecorder) UpdateTimeToLiveWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeToLiveWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).UpdateTimeToLiveWithContext), varargs...)
+}
+
+// WaitUntilTableExists mocks base method
+func (m *MockDynamoDBClient) WaitUntilTableExists(arg0 *dynamodb.DescribeTableInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilTableExists", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilTableExists indicates an expected call of WaitUntilTableExists
+func (mr *MockDynamoDBClientMockRecorder) WaitUntilTableExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilTableExists", reflect.TypeOf((*MockDynamoDBClient)(nil).WaitUntilTableExists), arg0)
+}
+
+// WaitUntilTableExistsWithContext mocks base method
+func (m *MockDynamoDBClient) WaitUntilTableExistsWithContext(arg0 context.Context, arg1 *dynamodb.DescribeTableInput, arg2 ...request.WaiterOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitUntilTableExistsWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilTableExistsWithContext indicates an expected call of WaitUntilTableExistsWithContext
+func (mr *MockDynamoDBClientMockRecorder) WaitUntilTableExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilTableExistsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).WaitUntilTableExistsWithContext), varargs...)
+}
+
+// WaitUntilTableNotExists mocks base method
+func (m *MockDynamoDBClient) WaitUntilTableNotExists(arg0 *dynamodb.DescribeTableInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilTableNotExists", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilTableNotExists indicates an expected call of WaitUntilTableNotExists
+func (mr *MockDynamoDBClientMockRecorder) WaitUntilTableNotExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilTableNotExists", reflect.TypeOf((*MockDynamoDBClient)(nil).WaitUntilTableNotExists), arg0)
+}
+
+// WaitUntilTableNotExistsWithContext mocks base method
+func (m *MockDynamoDBClient) WaitUntilTableNotExistsWithContext(arg0 context.Context, arg1 *dynamodb.DescribeTableInput, arg2 ...request.WaiterOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitUntilTableNotExistsWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilTableNotExistsWithContext indicates an expected call of WaitUntilTableNotExistsWithContext
+func (mr *MockDynamoDBClientMockRecorder) WaitUntilTableNotExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilTableNotExistsWithContext", reflect.TypeOf((*MockDynamoDBClient)(nil).WaitUntilTableNotExistsWithContext), varargs...)
+}
@@ -65,6 +65,22 @@ func (mr *MockRepoClientMockRecorder) DeleteKey(arg0, arg1, arg2, arg3 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKey", reflect.TypeOf((*MockRepoClient)(nil).DeleteKey), arg0, arg1, arg2, arg3)
 }
 
+// Get mocks base method
+func (m *MockRepoClient) Get(arg0 context.Context, arg1, arg2 string) (*github.Repository, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*github.Repository)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get
+func (mr *MockRepoClientMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepoClient)(nil).Get), arg0, arg1, arg2)
+}
+
 // ListKeys mocks base method
 func (m *MockRepoClient) ListKeys(arg0 context.Context, arg1, arg2 string, arg3 *github.ListOptions) ([]*github.Key, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,7588 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/telia-oss/concourse-github-lambda (interfaces: SSMClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	request "github.com/aws/aws-sdk-go/aws/request"
+	ssm "github.com/aws/aws-sdk-go/service/ssm"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockSSMClient is a mock of SSMClient interface
+type MockSSMClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSMClientMockRecorder
+}
+
+// MockSSMClientMockRecorder is the mock recorder for MockSSMClient
+type MockSSMClientMockRecorder struct {
+	mock *MockSSMClient
+}
+
+// NewMockSSMClient creates a new mock instance
+func NewMockSSMClient(ctrl *gomock.Controller) *MockSSMClient {
+	mock := &MockSSMClient{ctrl: ctrl}
+	mock.recorder = &MockSSMClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSSMClient) EXPECT() *MockSSMClientMockRecorder {
+	return m.recorder
+}
+
+// AddTagsToResource mocks base method
+func (m *MockSSMClient) AddTagsToResource(arg0 *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsToResource", arg0)
+	ret0, _ := ret[0].(*ssm.AddTagsToResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagsToResource indicates an expected call of AddTagsToResource
+func (mr *MockSSMClientMockRecorder) AddTagsToResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToResource", reflect.TypeOf((*MockSSMClient)(nil).AddTagsToResource), arg0)
+}
+
+// AddTagsToResourceRequest mocks base method
+func (m *MockSSMClient) AddTagsToResourceRequest(arg0 *ssm.AddTagsToResourceInput) (*request.Request, *ssm.AddTagsToResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsToResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.AddTagsToResourceOutput)
+	return ret0, ret1
+}
+
+// AddTagsToResourceRequest indicates an expected call of AddTagsToResourceRequest
+func (mr *MockSSMClientMockRecorder) AddTagsToResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToResourceRequest", reflect.TypeOf((*MockSSMClient)(nil).AddTagsToResourceRequest), arg0)
+}
+
+// AddTagsToResourceWithContext mocks base method
+func (m *MockSSMClient) AddTagsToResourceWithContext(arg0 context.Context, arg1 *ssm.AddTagsToResourceInput, arg2 ...request.Option) (*ssm.AddTagsToResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTagsToResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.AddTagsToResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagsToResourceWithContext indicates an expected call of AddTagsToResourceWithContext
+func (mr *MockSSMClientMockRecorder) AddTagsToResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToResourceWithContext", reflect.TypeOf((*MockSSMClient)(nil).AddTagsToResourceWithContext), varargs...)
+}
+
+// CancelCommand mocks base method
+func (m *MockSSMClient) CancelCommand(arg0 *ssm.CancelCommandInput) (*ssm.CancelCommandOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelCommand", arg0)
+	ret0, _ := ret[0].(*ssm.CancelCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelCommand indicates an expected call of CancelCommand
+func (mr *MockSSMClientMockRecorder) CancelCommand(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCommand", reflect.TypeOf((*MockSSMClient)(nil).CancelCommand), arg0)
+}
+
+// CancelCommandRequest mocks base method
+func (m *MockSSMClient) CancelCommandRequest(arg0 *ssm.CancelCommandInput) (*request.Request, *ssm.CancelCommandOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelCommandRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CancelCommandOutput)
+	return ret0, ret1
+}
+
+// CancelCommandRequest indicates an expected call of CancelCommandRequest
+func (mr *MockSSMClientMockRecorder) CancelCommandRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCommandRequest", reflect.TypeOf((*MockSSMClient)(nil).CancelCommandRequest), arg0)
+}
+
+// CancelCommandWithContext mocks base method
+func (m *MockSSMClient) CancelCommandWithContext(arg0 context.Context, arg1 *ssm.CancelCommandInput, arg2 ...request.Option) (*ssm.CancelCommandOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelCommandWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CancelCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelCommandWithContext indicates an expected call of CancelCommandWithContext
+func (mr *MockSSMClientMockRecorder) CancelCommandWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCommandWithContext", reflect.TypeOf((*MockSSMClient)(nil).CancelCommandWithContext), varargs...)
+}
+
+// CancelMaintenanceWindowExecution mocks base method
+func (m *MockSSMClient) CancelMaintenanceWindowExecution(arg0 *ssm.CancelMaintenanceWindowExecutionInput) (*ssm.CancelMaintenanceWindowExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMaintenanceWindowExecution", arg0)
+	ret0, _ := ret[0].(*ssm.CancelMaintenanceWindowExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMaintenanceWindowExecution indicates an expected call of CancelMaintenanceWindowExecution
+func (mr *MockSSMClientMockRecorder) CancelMaintenanceWindowExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMaintenanceWindowExecution", reflect.TypeOf((*MockSSMClient)(nil).CancelMaintenanceWindowExecution), arg0)
+}
+
+// CancelMaintenanceWindowExecutionRequest mocks base method
+func (m *MockSSMClient) CancelMaintenanceWindowExecutionRequest(arg0 *ssm.CancelMaintenanceWindowExecutionInput) (*request.Request, *ssm.CancelMaintenanceWindowExecutionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMaintenanceWindowExecutionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CancelMaintenanceWindowExecutionOutput)
+	return ret0, ret1
+}
+
+// CancelMaintenanceWindowExecutionRequest indicates an expected call of CancelMaintenanceWindowExecutionRequest
+func (mr *MockSSMClientMockRecorder) CancelMaintenanceWindowExecutionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMaintenanceWindowExecutionRequest", reflect.TypeOf((*MockSSMClient)(nil).CancelMaintenanceWindowExecutionRequest), arg0)
+}
+
+// CancelMaintenanceWindowExecutionWithContext mocks base method
+func (m *MockSSMClient) CancelMaintenanceWindowExecutionWithContext(arg0 context.Context, arg1 *ssm.CancelMaintenanceWindowExecutionInput, arg2 ...request.Option) (*ssm.CancelMaintenanceWindowExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelMaintenanceWindowExecutionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CancelMaintenanceWindowExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMaintenanceWindowExecutionWithContext indicates an expected call of CancelMaintenanceWindowExecutionWithContext
+func (mr *MockSSMClientMockRecorder) CancelMaintenanceWindowExecutionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMaintenanceWindowExecutionWithContext", reflect.TypeOf((*MockSSMClient)(nil).CancelMaintenanceWindowExecutionWithContext), varargs...)
+}
+
+// CreateActivation mocks base method
+func (m *MockSSMClient) CreateActivation(arg0 *ssm.CreateActivationInput) (*ssm.CreateActivationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActivation", arg0)
+	ret0, _ := ret[0].(*ssm.CreateActivationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateActivation indicates an expected call of CreateActivation
+func (mr *MockSSMClientMockRecorder) CreateActivation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActivation", reflect.TypeOf((*MockSSMClient)(nil).CreateActivation), arg0)
+}
+
+// CreateActivationRequest mocks base method
+func (m *MockSSMClient) CreateActivationRequest(arg0 *ssm.CreateActivationInput) (*request.Request, *ssm.CreateActivationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActivationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateActivationOutput)
+	return ret0, ret1
+}
+
+// CreateActivationRequest indicates an expected call of CreateActivationRequest
+func (mr *MockSSMClientMockRecorder) CreateActivationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActivationRequest", reflect.TypeOf((*MockSSMClient)(nil).CreateActivationRequest), arg0)
+}
+
+// CreateActivationWithContext mocks base method
+func (m *MockSSMClient) CreateActivationWithContext(arg0 context.Context, arg1 *ssm.CreateActivationInput, arg2 ...request.Option) (*ssm.CreateActivationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateActivationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreateActivationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateActivationWithContext indicates an expected call of CreateActivationWithContext
+func (mr *MockSSMClientMockRecorder) CreateActivationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActivationWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreateActivationWithContext), varargs...)
+}
+
+// CreateAssociation mocks base method
+func (m *MockSSMClient) CreateAssociation(arg0 *ssm.CreateAssociationInput) (*ssm.CreateAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssociation", arg0)
+	ret0, _ := ret[0].(*ssm.CreateAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssociation indicates an expected call of CreateAssociation
+func (mr *MockSSMClientMockRecorder) CreateAssociation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssociation", reflect.TypeOf((*MockSSMClient)(nil).CreateAssociation), arg0)
+}
+
+// CreateAssociationBatch mocks base method
+func (m *MockSSMClient) CreateAssociationBatch(arg0 *ssm.CreateAssociationBatchInput) (*ssm.CreateAssociationBatchOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssociationBatch", arg0)
+	ret0, _ := ret[0].(*ssm.CreateAssociationBatchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssociationBatch indicates an expected call of CreateAssociationBatch
+func (mr *MockSSMClientMockRecorder) CreateAssociationBatch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssociationBatch", reflect.TypeOf((*MockSSMClient)(nil).CreateAssociationBatch), arg0)
+}
+
+// CreateAssociationBatchRequest mocks base method
+func (m *MockSSMClient) CreateAssociationBatchRequest(arg0 *ssm.CreateAssociationBatchInput) (*request.Request, *ssm.CreateAssociationBatchOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssociationBatchRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateAssociationBatchOutput)
+	return ret0, ret1
+}
+
+// CreateAssociationBatchRequest indicates an expected call of CreateAssociationBatchRequest
+func (mr *MockSSMClientMockRecorder) CreateAssociationBatchRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssociationBatchRequest", reflect.TypeOf((*MockSSMClient)(nil).CreateAssociationBatchRequest), arg0)
+}
+
+// CreateAssociationBatchWithContext mocks base method
+func (m *MockSSMClient) CreateAssociationBatchWithContext(arg0 context.Context, arg1 *ssm.CreateAssociationBatchInput, arg2 ...request.Option) (*ssm.CreateAssociationBatchOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAssociationBatchWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreateAssociationBatchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssociationBatchWithContext indicates an expected call of CreateAssociationBatchWithContext
+func (mr *MockSSMClientMockRecorder) CreateAssociationBatchWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssociationBatchWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreateAssociationBatchWithContext), varargs...)
+}
+
+// CreateAssociationRequest mocks base method
+func (m *MockSSMClient) CreateAssociationRequest(arg0 *ssm.CreateAssociationInput) (*request.Request, *ssm.CreateAssociationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssociationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateAssociationOutput)
+	return ret0, ret1
+}
+
+// CreateAssociationRequest indicates an expected call of CreateAssociationRequest
+func (mr *MockSSMClientMockRecorder) CreateAssociationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssociationRequest", reflect.TypeOf((*MockSSMClient)(nil).CreateAssociationRequest), arg0)
+}
+
+// CreateAssociationWithContext mocks base method
+func (m *MockSSMClient) CreateAssociationWithContext(arg0 context.Context, arg1 *ssm.CreateAssociationInput, arg2 ...request.Option) (*ssm.CreateAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAssociationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreateAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssociationWithContext indicates an expected call of CreateAssociationWithContext
+func (mr *MockSSMClientMockRecorder) CreateAssociationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssociationWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreateAssociationWithContext), varargs...)
+}
+
+// CreateDocument mocks base method
+func (m *MockSSMClient) CreateDocument(arg0 *ssm.CreateDocumentInput) (*ssm.CreateDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocument", arg0)
+	ret0, _ := ret[0].(*ssm.CreateDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDocument indicates an expected call of CreateDocument
+func (mr *MockSSMClientMockRecorder) CreateDocument(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocument", reflect.TypeOf((*MockSSMClient)(nil).CreateDocument), arg0)
+}
+
+// CreateDocumentRequest mocks base method
+func (m *MockSSMClient) CreateDocumentRequest(arg0 *ssm.CreateDocumentInput) (*request.Request, *ssm.CreateDocumentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocumentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateDocumentOutput)
+	return ret0, ret1
+}
+
+// CreateDocumentRequest indicates an expected call of CreateDocumentRequest
+func (mr *MockSSMClientMockRecorder) CreateDocumentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocumentRequest", reflect.TypeOf((*MockSSMClient)(nil).CreateDocumentRequest), arg0)
+}
+
+// CreateDocumentWithContext mocks base method
+func (m *MockSSMClient) CreateDocumentWithContext(arg0 context.Context, arg1 *ssm.CreateDocumentInput, arg2 ...request.Option) (*ssm.CreateDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDocumentWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreateDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDocumentWithContext indicates an expected call of CreateDocumentWithContext
+func (mr *MockSSMClientMockRecorder) CreateDocumentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocumentWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreateDocumentWithContext), varargs...)
+}
+
+// CreateMaintenanceWindow mocks base method
+func (m *MockSSMClient) CreateMaintenanceWindow(arg0 *ssm.CreateMaintenanceWindowInput) (*ssm.CreateMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.CreateMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMaintenanceWindow indicates an expected call of CreateMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) CreateMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).CreateMaintenanceWindow), arg0)
+}
+
+// CreateMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) CreateMaintenanceWindowRequest(arg0 *ssm.CreateMaintenanceWindowInput) (*request.Request, *ssm.CreateMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// CreateMaintenanceWindowRequest indicates an expected call of CreateMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) CreateMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).CreateMaintenanceWindowRequest), arg0)
+}
+
+// CreateMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) CreateMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.CreateMaintenanceWindowInput, arg2 ...request.Option) (*ssm.CreateMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreateMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMaintenanceWindowWithContext indicates an expected call of CreateMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) CreateMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreateMaintenanceWindowWithContext), varargs...)
+}
+
+// CreateOpsItem mocks base method
+func (m *MockSSMClient) CreateOpsItem(arg0 *ssm.CreateOpsItemInput) (*ssm.CreateOpsItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOpsItem", arg0)
+	ret0, _ := ret[0].(*ssm.CreateOpsItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOpsItem indicates an expected call of CreateOpsItem
+func (mr *MockSSMClientMockRecorder) CreateOpsItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpsItem", reflect.TypeOf((*MockSSMClient)(nil).CreateOpsItem), arg0)
+}
+
+// CreateOpsItemRequest mocks base method
+func (m *MockSSMClient) CreateOpsItemRequest(arg0 *ssm.CreateOpsItemInput) (*request.Request, *ssm.CreateOpsItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOpsItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateOpsItemOutput)
+	return ret0, ret1
+}
+
+// CreateOpsItemRequest indicates an expected call of CreateOpsItemRequest
+func (mr *MockSSMClientMockRecorder) CreateOpsItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpsItemRequest", reflect.TypeOf((*MockSSMClient)(nil).CreateOpsItemRequest), arg0)
+}
+
+// CreateOpsItemWithContext mocks base method
+func (m *MockSSMClient) CreateOpsItemWithContext(arg0 context.Context, arg1 *ssm.CreateOpsItemInput, arg2 ...request.Option) (*ssm.CreateOpsItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOpsItemWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreateOpsItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOpsItemWithContext indicates an expected call of CreateOpsItemWithContext
+func (mr *MockSSMClientMockRecorder) CreateOpsItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpsItemWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreateOpsItemWithContext), varargs...)
+}
+
+// CreatePatchBaseline mocks base method
+func (m *MockSSMClient) CreatePatchBaseline(arg0 *ssm.CreatePatchBaselineInput) (*ssm.CreatePatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePatchBaseline", arg0)
+	ret0, _ := ret[0].(*ssm.CreatePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePatchBaseline indicates an expected call of CreatePatchBaseline
+func (mr *MockSSMClientMockRecorder) CreatePatchBaseline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePatchBaseline", reflect.TypeOf((*MockSSMClient)(nil).CreatePatchBaseline), arg0)
+}
+
+// CreatePatchBaselineRequest mocks base method
+func (m *MockSSMClient) CreatePatchBaselineRequest(arg0 *ssm.CreatePatchBaselineInput) (*request.Request, *ssm.CreatePatchBaselineOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePatchBaselineRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreatePatchBaselineOutput)
+	return ret0, ret1
+}
+
+// CreatePatchBaselineRequest indicates an expected call of CreatePatchBaselineRequest
+func (mr *MockSSMClientMockRecorder) CreatePatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePatchBaselineRequest", reflect.TypeOf((*MockSSMClient)(nil).CreatePatchBaselineRequest), arg0)
+}
+
+// CreatePatchBaselineWithContext mocks base method
+func (m *MockSSMClient) CreatePatchBaselineWithContext(arg0 context.Context, arg1 *ssm.CreatePatchBaselineInput, arg2 ...request.Option) (*ssm.CreatePatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePatchBaselineWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreatePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePatchBaselineWithContext indicates an expected call of CreatePatchBaselineWithContext
+func (mr *MockSSMClientMockRecorder) CreatePatchBaselineWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePatchBaselineWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreatePatchBaselineWithContext), varargs...)
+}
+
+// CreateResourceDataSync mocks base method
+func (m *MockSSMClient) CreateResourceDataSync(arg0 *ssm.CreateResourceDataSyncInput) (*ssm.CreateResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResourceDataSync", arg0)
+	ret0, _ := ret[0].(*ssm.CreateResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResourceDataSync indicates an expected call of CreateResourceDataSync
+func (mr *MockSSMClientMockRecorder) CreateResourceDataSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceDataSync", reflect.TypeOf((*MockSSMClient)(nil).CreateResourceDataSync), arg0)
+}
+
+// CreateResourceDataSyncRequest mocks base method
+func (m *MockSSMClient) CreateResourceDataSyncRequest(arg0 *ssm.CreateResourceDataSyncInput) (*request.Request, *ssm.CreateResourceDataSyncOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResourceDataSyncRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.CreateResourceDataSyncOutput)
+	return ret0, ret1
+}
+
+// CreateResourceDataSyncRequest indicates an expected call of CreateResourceDataSyncRequest
+func (mr *MockSSMClientMockRecorder) CreateResourceDataSyncRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceDataSyncRequest", reflect.TypeOf((*MockSSMClient)(nil).CreateResourceDataSyncRequest), arg0)
+}
+
+// CreateResourceDataSyncWithContext mocks base method
+func (m *MockSSMClient) CreateResourceDataSyncWithContext(arg0 context.Context, arg1 *ssm.CreateResourceDataSyncInput, arg2 ...request.Option) (*ssm.CreateResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateResourceDataSyncWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.CreateResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResourceDataSyncWithContext indicates an expected call of CreateResourceDataSyncWithContext
+func (mr *MockSSMClientMockRecorder) CreateResourceDataSyncWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceDataSyncWithContext", reflect.TypeOf((*MockSSMClient)(nil).CreateResourceDataSyncWithContext), varargs...)
+}
+
+// DeleteActivation mocks base method
+func (m *MockSSMClient) DeleteActivation(arg0 *ssm.DeleteActivationInput) (*ssm.DeleteActivationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActivation", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteActivationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteActivation indicates an expected call of DeleteActivation
+func (mr *MockSSMClientMockRecorder) DeleteActivation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActivation", reflect.TypeOf((*MockSSMClient)(nil).DeleteActivation), arg0)
+}
+
+// DeleteActivationRequest mocks base method
+func (m *MockSSMClient) DeleteActivationRequest(arg0 *ssm.DeleteActivationInput) (*request.Request, *ssm.DeleteActivationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActivationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteActivationOutput)
+	return ret0, ret1
+}
+
+// DeleteActivationRequest indicates an expected call of DeleteActivationRequest
+func (mr *MockSSMClientMockRecorder) DeleteActivationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActivationRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteActivationRequest), arg0)
+}
+
+// DeleteActivationWithContext mocks base method
+func (m *MockSSMClient) DeleteActivationWithContext(arg0 context.Context, arg1 *ssm.DeleteActivationInput, arg2 ...request.Option) (*ssm.DeleteActivationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteActivationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteActivationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteActivationWithContext indicates an expected call of DeleteActivationWithContext
+func (mr *MockSSMClientMockRecorder) DeleteActivationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActivationWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteActivationWithContext), varargs...)
+}
+
+// DeleteAssociation mocks base method
+func (m *MockSSMClient) DeleteAssociation(arg0 *ssm.DeleteAssociationInput) (*ssm.DeleteAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssociation", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAssociation indicates an expected call of DeleteAssociation
+func (mr *MockSSMClientMockRecorder) DeleteAssociation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssociation", reflect.TypeOf((*MockSSMClient)(nil).DeleteAssociation), arg0)
+}
+
+// DeleteAssociationRequest mocks base method
+func (m *MockSSMClient) DeleteAssociationRequest(arg0 *ssm.DeleteAssociationInput) (*request.Request, *ssm.DeleteAssociationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssociationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteAssociationOutput)
+	return ret0, ret1
+}
+
+// DeleteAssociationRequest indicates an expected call of DeleteAssociationRequest
+func (mr *MockSSMClientMockRecorder) DeleteAssociationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssociationRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteAssociationRequest), arg0)
+}
+
+// DeleteAssociationWithContext mocks base method
+func (m *MockSSMClient) DeleteAssociationWithContext(arg0 context.Context, arg1 *ssm.DeleteAssociationInput, arg2 ...request.Option) (*ssm.DeleteAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAssociationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAssociationWithContext indicates an expected call of DeleteAssociationWithContext
+func (mr *MockSSMClientMockRecorder) DeleteAssociationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssociationWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteAssociationWithContext), varargs...)
+}
+
+// DeleteDocument mocks base method
+func (m *MockSSMClient) DeleteDocument(arg0 *ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDocument", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDocument indicates an expected call of DeleteDocument
+func (mr *MockSSMClientMockRecorder) DeleteDocument(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocument", reflect.TypeOf((*MockSSMClient)(nil).DeleteDocument), arg0)
+}
+
+// DeleteDocumentRequest mocks base method
+func (m *MockSSMClient) DeleteDocumentRequest(arg0 *ssm.DeleteDocumentInput) (*request.Request, *ssm.DeleteDocumentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDocumentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteDocumentOutput)
+	return ret0, ret1
+}
+
+// DeleteDocumentRequest indicates an expected call of DeleteDocumentRequest
+func (mr *MockSSMClientMockRecorder) DeleteDocumentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocumentRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteDocumentRequest), arg0)
+}
+
+// DeleteDocumentWithContext mocks base method
+func (m *MockSSMClient) DeleteDocumentWithContext(arg0 context.Context, arg1 *ssm.DeleteDocumentInput, arg2 ...request.Option) (*ssm.DeleteDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDocumentWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDocumentWithContext indicates an expected call of DeleteDocumentWithContext
+func (mr *MockSSMClientMockRecorder) DeleteDocumentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocumentWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteDocumentWithContext), varargs...)
+}
+
+// DeleteInventory mocks base method
+func (m *MockSSMClient) DeleteInventory(arg0 *ssm.DeleteInventoryInput) (*ssm.DeleteInventoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInventory", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInventory indicates an expected call of DeleteInventory
+func (mr *MockSSMClientMockRecorder) DeleteInventory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInventory", reflect.TypeOf((*MockSSMClient)(nil).DeleteInventory), arg0)
+}
+
+// DeleteInventoryRequest mocks base method
+func (m *MockSSMClient) DeleteInventoryRequest(arg0 *ssm.DeleteInventoryInput) (*request.Request, *ssm.DeleteInventoryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInventoryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteInventoryOutput)
+	return ret0, ret1
+}
+
+// DeleteInventoryRequest indicates an expected call of DeleteInventoryRequest
+func (mr *MockSSMClientMockRecorder) DeleteInventoryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInventoryRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteInventoryRequest), arg0)
+}
+
+// DeleteInventoryWithContext mocks base method
+func (m *MockSSMClient) DeleteInventoryWithContext(arg0 context.Context, arg1 *ssm.DeleteInventoryInput, arg2 ...request.Option) (*ssm.DeleteInventoryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteInventoryWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInventoryWithContext indicates an expected call of DeleteInventoryWithContext
+func (mr *MockSSMClientMockRecorder) DeleteInventoryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInventoryWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteInventoryWithContext), varargs...)
+}
+
+// DeleteMaintenanceWindow mocks base method
+func (m *MockSSMClient) DeleteMaintenanceWindow(arg0 *ssm.DeleteMaintenanceWindowInput) (*ssm.DeleteMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMaintenanceWindow indicates an expected call of DeleteMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) DeleteMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).DeleteMaintenanceWindow), arg0)
+}
+
+// DeleteMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) DeleteMaintenanceWindowRequest(arg0 *ssm.DeleteMaintenanceWindowInput) (*request.Request, *ssm.DeleteMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// DeleteMaintenanceWindowRequest indicates an expected call of DeleteMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) DeleteMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteMaintenanceWindowRequest), arg0)
+}
+
+// DeleteMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) DeleteMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.DeleteMaintenanceWindowInput, arg2 ...request.Option) (*ssm.DeleteMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMaintenanceWindowWithContext indicates an expected call of DeleteMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) DeleteMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteMaintenanceWindowWithContext), varargs...)
+}
+
+// DeleteParameter mocks base method
+func (m *MockSSMClient) DeleteParameter(arg0 *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParameter", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteParameter indicates an expected call of DeleteParameter
+func (mr *MockSSMClientMockRecorder) DeleteParameter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParameter", reflect.TypeOf((*MockSSMClient)(nil).DeleteParameter), arg0)
+}
+
+// DeleteParameterRequest mocks base method
+func (m *MockSSMClient) DeleteParameterRequest(arg0 *ssm.DeleteParameterInput) (*request.Request, *ssm.DeleteParameterOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParameterRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteParameterOutput)
+	return ret0, ret1
+}
+
+// DeleteParameterRequest indicates an expected call of DeleteParameterRequest
+func (mr *MockSSMClientMockRecorder) DeleteParameterRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParameterRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteParameterRequest), arg0)
+}
+
+// DeleteParameterWithContext mocks base method
+func (m *MockSSMClient) DeleteParameterWithContext(arg0 context.Context, arg1 *ssm.DeleteParameterInput, arg2 ...request.Option) (*ssm.DeleteParameterOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteParameterWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteParameterWithContext indicates an expected call of DeleteParameterWithContext
+func (mr *MockSSMClientMockRecorder) DeleteParameterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParameterWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteParameterWithContext), varargs...)
+}
+
+// DeleteParameters mocks base method
+func (m *MockSSMClient) DeleteParameters(arg0 *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParameters", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteParameters indicates an expected call of DeleteParameters
+func (mr *MockSSMClientMockRecorder) DeleteParameters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParameters", reflect.TypeOf((*MockSSMClient)(nil).DeleteParameters), arg0)
+}
+
+// DeleteParametersRequest mocks base method
+func (m *MockSSMClient) DeleteParametersRequest(arg0 *ssm.DeleteParametersInput) (*request.Request, *ssm.DeleteParametersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParametersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteParametersOutput)
+	return ret0, ret1
+}
+
+// DeleteParametersRequest indicates an expected call of DeleteParametersRequest
+func (mr *MockSSMClientMockRecorder) DeleteParametersRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParametersRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteParametersRequest), arg0)
+}
+
+// DeleteParametersWithContext mocks base method
+func (m *MockSSMClient) DeleteParametersWithContext(arg0 context.Context, arg1 *ssm.DeleteParametersInput, arg2 ...request.Option) (*ssm.DeleteParametersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteParametersWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteParametersWithContext indicates an expected call of DeleteParametersWithContext
+func (mr *MockSSMClientMockRecorder) DeleteParametersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParametersWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteParametersWithContext), varargs...)
+}
+
+// DeletePatchBaseline mocks base method
+func (m *MockSSMClient) DeletePatchBaseline(arg0 *ssm.DeletePatchBaselineInput) (*ssm.DeletePatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePatchBaseline", arg0)
+	ret0, _ := ret[0].(*ssm.DeletePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePatchBaseline indicates an expected call of DeletePatchBaseline
+func (mr *MockSSMClientMockRecorder) DeletePatchBaseline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePatchBaseline", reflect.TypeOf((*MockSSMClient)(nil).DeletePatchBaseline), arg0)
+}
+
+// DeletePatchBaselineRequest mocks base method
+func (m *MockSSMClient) DeletePatchBaselineRequest(arg0 *ssm.DeletePatchBaselineInput) (*request.Request, *ssm.DeletePatchBaselineOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePatchBaselineRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeletePatchBaselineOutput)
+	return ret0, ret1
+}
+
+// DeletePatchBaselineRequest indicates an expected call of DeletePatchBaselineRequest
+func (mr *MockSSMClientMockRecorder) DeletePatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePatchBaselineRequest", reflect.TypeOf((*MockSSMClient)(nil).DeletePatchBaselineRequest), arg0)
+}
+
+// DeletePatchBaselineWithContext mocks base method
+func (m *MockSSMClient) DeletePatchBaselineWithContext(arg0 context.Context, arg1 *ssm.DeletePatchBaselineInput, arg2 ...request.Option) (*ssm.DeletePatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePatchBaselineWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeletePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePatchBaselineWithContext indicates an expected call of DeletePatchBaselineWithContext
+func (mr *MockSSMClientMockRecorder) DeletePatchBaselineWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePatchBaselineWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeletePatchBaselineWithContext), varargs...)
+}
+
+// DeleteResourceDataSync mocks base method
+func (m *MockSSMClient) DeleteResourceDataSync(arg0 *ssm.DeleteResourceDataSyncInput) (*ssm.DeleteResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceDataSync", arg0)
+	ret0, _ := ret[0].(*ssm.DeleteResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceDataSync indicates an expected call of DeleteResourceDataSync
+func (mr *MockSSMClientMockRecorder) DeleteResourceDataSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceDataSync", reflect.TypeOf((*MockSSMClient)(nil).DeleteResourceDataSync), arg0)
+}
+
+// DeleteResourceDataSyncRequest mocks base method
+func (m *MockSSMClient) DeleteResourceDataSyncRequest(arg0 *ssm.DeleteResourceDataSyncInput) (*request.Request, *ssm.DeleteResourceDataSyncOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceDataSyncRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeleteResourceDataSyncOutput)
+	return ret0, ret1
+}
+
+// DeleteResourceDataSyncRequest indicates an expected call of DeleteResourceDataSyncRequest
+func (mr *MockSSMClientMockRecorder) DeleteResourceDataSyncRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceDataSyncRequest", reflect.TypeOf((*MockSSMClient)(nil).DeleteResourceDataSyncRequest), arg0)
+}
+
+// DeleteResourceDataSyncWithContext mocks base method
+func (m *MockSSMClient) DeleteResourceDataSyncWithContext(arg0 context.Context, arg1 *ssm.DeleteResourceDataSyncInput, arg2 ...request.Option) (*ssm.DeleteResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResourceDataSyncWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeleteResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceDataSyncWithContext indicates an expected call of DeleteResourceDataSyncWithContext
+func (mr *MockSSMClientMockRecorder) DeleteResourceDataSyncWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceDataSyncWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeleteResourceDataSyncWithContext), varargs...)
+}
+
+// DeregisterManagedInstance mocks base method
+func (m *MockSSMClient) DeregisterManagedInstance(arg0 *ssm.DeregisterManagedInstanceInput) (*ssm.DeregisterManagedInstanceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterManagedInstance", arg0)
+	ret0, _ := ret[0].(*ssm.DeregisterManagedInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterManagedInstance indicates an expected call of DeregisterManagedInstance
+func (mr *MockSSMClientMockRecorder) DeregisterManagedInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterManagedInstance", reflect.TypeOf((*MockSSMClient)(nil).DeregisterManagedInstance), arg0)
+}
+
+// DeregisterManagedInstanceRequest mocks base method
+func (m *MockSSMClient) DeregisterManagedInstanceRequest(arg0 *ssm.DeregisterManagedInstanceInput) (*request.Request, *ssm.DeregisterManagedInstanceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterManagedInstanceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterManagedInstanceOutput)
+	return ret0, ret1
+}
+
+// DeregisterManagedInstanceRequest indicates an expected call of DeregisterManagedInstanceRequest
+func (mr *MockSSMClientMockRecorder) DeregisterManagedInstanceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterManagedInstanceRequest", reflect.TypeOf((*MockSSMClient)(nil).DeregisterManagedInstanceRequest), arg0)
+}
+
+// DeregisterManagedInstanceWithContext mocks base method
+func (m *MockSSMClient) DeregisterManagedInstanceWithContext(arg0 context.Context, arg1 *ssm.DeregisterManagedInstanceInput, arg2 ...request.Option) (*ssm.DeregisterManagedInstanceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeregisterManagedInstanceWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeregisterManagedInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterManagedInstanceWithContext indicates an expected call of DeregisterManagedInstanceWithContext
+func (mr *MockSSMClientMockRecorder) DeregisterManagedInstanceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterManagedInstanceWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeregisterManagedInstanceWithContext), varargs...)
+}
+
+// DeregisterPatchBaselineForPatchGroup mocks base method
+func (m *MockSSMClient) DeregisterPatchBaselineForPatchGroup(arg0 *ssm.DeregisterPatchBaselineForPatchGroupInput) (*ssm.DeregisterPatchBaselineForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterPatchBaselineForPatchGroup", arg0)
+	ret0, _ := ret[0].(*ssm.DeregisterPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterPatchBaselineForPatchGroup indicates an expected call of DeregisterPatchBaselineForPatchGroup
+func (mr *MockSSMClientMockRecorder) DeregisterPatchBaselineForPatchGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterPatchBaselineForPatchGroup", reflect.TypeOf((*MockSSMClient)(nil).DeregisterPatchBaselineForPatchGroup), arg0)
+}
+
+// DeregisterPatchBaselineForPatchGroupRequest mocks base method
+func (m *MockSSMClient) DeregisterPatchBaselineForPatchGroupRequest(arg0 *ssm.DeregisterPatchBaselineForPatchGroupInput) (*request.Request, *ssm.DeregisterPatchBaselineForPatchGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterPatchBaselineForPatchGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterPatchBaselineForPatchGroupOutput)
+	return ret0, ret1
+}
+
+// DeregisterPatchBaselineForPatchGroupRequest indicates an expected call of DeregisterPatchBaselineForPatchGroupRequest
+func (mr *MockSSMClientMockRecorder) DeregisterPatchBaselineForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterPatchBaselineForPatchGroupRequest", reflect.TypeOf((*MockSSMClient)(nil).DeregisterPatchBaselineForPatchGroupRequest), arg0)
+}
+
+// DeregisterPatchBaselineForPatchGroupWithContext mocks base method
+func (m *MockSSMClient) DeregisterPatchBaselineForPatchGroupWithContext(arg0 context.Context, arg1 *ssm.DeregisterPatchBaselineForPatchGroupInput, arg2 ...request.Option) (*ssm.DeregisterPatchBaselineForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeregisterPatchBaselineForPatchGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeregisterPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterPatchBaselineForPatchGroupWithContext indicates an expected call of DeregisterPatchBaselineForPatchGroupWithContext
+func (mr *MockSSMClientMockRecorder) DeregisterPatchBaselineForPatchGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterPatchBaselineForPatchGroupWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeregisterPatchBaselineForPatchGroupWithContext), varargs...)
+}
+
+// DeregisterTargetFromMaintenanceWindow mocks base method
+func (m *MockSSMClient) DeregisterTargetFromMaintenanceWindow(arg0 *ssm.DeregisterTargetFromMaintenanceWindowInput) (*ssm.DeregisterTargetFromMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTargetFromMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.DeregisterTargetFromMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterTargetFromMaintenanceWindow indicates an expected call of DeregisterTargetFromMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) DeregisterTargetFromMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTargetFromMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).DeregisterTargetFromMaintenanceWindow), arg0)
+}
+
+// DeregisterTargetFromMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) DeregisterTargetFromMaintenanceWindowRequest(arg0 *ssm.DeregisterTargetFromMaintenanceWindowInput) (*request.Request, *ssm.DeregisterTargetFromMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTargetFromMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterTargetFromMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// DeregisterTargetFromMaintenanceWindowRequest indicates an expected call of DeregisterTargetFromMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) DeregisterTargetFromMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTargetFromMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).DeregisterTargetFromMaintenanceWindowRequest), arg0)
+}
+
+// DeregisterTargetFromMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) DeregisterTargetFromMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.DeregisterTargetFromMaintenanceWindowInput, arg2 ...request.Option) (*ssm.DeregisterTargetFromMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeregisterTargetFromMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeregisterTargetFromMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterTargetFromMaintenanceWindowWithContext indicates an expected call of DeregisterTargetFromMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) DeregisterTargetFromMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTargetFromMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeregisterTargetFromMaintenanceWindowWithContext), varargs...)
+}
+
+// DeregisterTaskFromMaintenanceWindow mocks base method
+func (m *MockSSMClient) DeregisterTaskFromMaintenanceWindow(arg0 *ssm.DeregisterTaskFromMaintenanceWindowInput) (*ssm.DeregisterTaskFromMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskFromMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.DeregisterTaskFromMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterTaskFromMaintenanceWindow indicates an expected call of DeregisterTaskFromMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) DeregisterTaskFromMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskFromMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).DeregisterTaskFromMaintenanceWindow), arg0)
+}
+
+// DeregisterTaskFromMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) DeregisterTaskFromMaintenanceWindowRequest(arg0 *ssm.DeregisterTaskFromMaintenanceWindowInput) (*request.Request, *ssm.DeregisterTaskFromMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskFromMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DeregisterTaskFromMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// DeregisterTaskFromMaintenanceWindowRequest indicates an expected call of DeregisterTaskFromMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) DeregisterTaskFromMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskFromMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).DeregisterTaskFromMaintenanceWindowRequest), arg0)
+}
+
+// DeregisterTaskFromMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) DeregisterTaskFromMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.DeregisterTaskFromMaintenanceWindowInput, arg2 ...request.Option) (*ssm.DeregisterTaskFromMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeregisterTaskFromMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DeregisterTaskFromMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterTaskFromMaintenanceWindowWithContext indicates an expected call of DeregisterTaskFromMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) DeregisterTaskFromMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskFromMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).DeregisterTaskFromMaintenanceWindowWithContext), varargs...)
+}
+
+// DescribeActivations mocks base method
+func (m *MockSSMClient) DescribeActivations(arg0 *ssm.DescribeActivationsInput) (*ssm.DescribeActivationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeActivations", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeActivationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeActivations indicates an expected call of DescribeActivations
+func (mr *MockSSMClientMockRecorder) DescribeActivations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeActivations", reflect.TypeOf((*MockSSMClient)(nil).DescribeActivations), arg0)
+}
+
+// DescribeActivationsPages mocks base method
+func (m *MockSSMClient) DescribeActivationsPages(arg0 *ssm.DescribeActivationsInput, arg1 func(*ssm.DescribeActivationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeActivationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeActivationsPages indicates an expected call of DescribeActivationsPages
+func (mr *MockSSMClientMockRecorder) DescribeActivationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeActivationsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeActivationsPages), arg0, arg1)
+}
+
+// DescribeActivationsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeActivationsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeActivationsInput, arg2 func(*ssm.DescribeActivationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeActivationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeActivationsPagesWithContext indicates an expected call of DescribeActivationsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeActivationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeActivationsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeActivationsPagesWithContext), varargs...)
+}
+
+// DescribeActivationsRequest mocks base method
+func (m *MockSSMClient) DescribeActivationsRequest(arg0 *ssm.DescribeActivationsInput) (*request.Request, *ssm.DescribeActivationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeActivationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeActivationsOutput)
+	return ret0, ret1
+}
+
+// DescribeActivationsRequest indicates an expected call of DescribeActivationsRequest
+func (mr *MockSSMClientMockRecorder) DescribeActivationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeActivationsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeActivationsRequest), arg0)
+}
+
+// DescribeActivationsWithContext mocks base method
+func (m *MockSSMClient) DescribeActivationsWithContext(arg0 context.Context, arg1 *ssm.DescribeActivationsInput, arg2 ...request.Option) (*ssm.DescribeActivationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeActivationsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeActivationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeActivationsWithContext indicates an expected call of DescribeActivationsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeActivationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeActivationsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeActivationsWithContext), varargs...)
+}
+
+// DescribeAssociation mocks base method
+func (m *MockSSMClient) DescribeAssociation(arg0 *ssm.DescribeAssociationInput) (*ssm.DescribeAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociation", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAssociation indicates an expected call of DescribeAssociation
+func (mr *MockSSMClientMockRecorder) DescribeAssociation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociation", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociation), arg0)
+}
+
+// DescribeAssociationExecutionTargets mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionTargets(arg0 *ssm.DescribeAssociationExecutionTargetsInput) (*ssm.DescribeAssociationExecutionTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionTargets", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeAssociationExecutionTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAssociationExecutionTargets indicates an expected call of DescribeAssociationExecutionTargets
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionTargets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionTargets", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionTargets), arg0)
+}
+
+// DescribeAssociationExecutionTargetsPages mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionTargetsPages(arg0 *ssm.DescribeAssociationExecutionTargetsInput, arg1 func(*ssm.DescribeAssociationExecutionTargetsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionTargetsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAssociationExecutionTargetsPages indicates an expected call of DescribeAssociationExecutionTargetsPages
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionTargetsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionTargetsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionTargetsPages), arg0, arg1)
+}
+
+// DescribeAssociationExecutionTargetsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionTargetsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeAssociationExecutionTargetsInput, arg2 func(*ssm.DescribeAssociationExecutionTargetsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionTargetsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAssociationExecutionTargetsPagesWithContext indicates an expected call of DescribeAssociationExecutionTargetsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionTargetsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionTargetsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionTargetsPagesWithContext), varargs...)
+}
+
+// DescribeAssociationExecutionTargetsRequest mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionTargetsRequest(arg0 *ssm.DescribeAssociationExecutionTargetsInput) (*request.Request, *ssm.DescribeAssociationExecutionTargetsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionTargetsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAssociationExecutionTargetsOutput)
+	return ret0, ret1
+}
+
+// DescribeAssociationExecutionTargetsRequest indicates an expected call of DescribeAssociationExecutionTargetsRequest
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionTargetsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionTargetsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionTargetsRequest), arg0)
+}
+
+// DescribeAssociationExecutionTargetsWithContext mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionTargetsWithContext(arg0 context.Context, arg1 *ssm.DescribeAssociationExecutionTargetsInput, arg2 ...request.Option) (*ssm.DescribeAssociationExecutionTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionTargetsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeAssociationExecutionTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAssociationExecutionTargetsWithContext indicates an expected call of DescribeAssociationExecutionTargetsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionTargetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionTargetsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionTargetsWithContext), varargs...)
+}
+
+// DescribeAssociationExecutions mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutions(arg0 *ssm.DescribeAssociationExecutionsInput) (*ssm.DescribeAssociationExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutions", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeAssociationExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAssociationExecutions indicates an expected call of DescribeAssociationExecutions
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutions", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutions), arg0)
+}
+
+// DescribeAssociationExecutionsPages mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionsPages(arg0 *ssm.DescribeAssociationExecutionsInput, arg1 func(*ssm.DescribeAssociationExecutionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAssociationExecutionsPages indicates an expected call of DescribeAssociationExecutionsPages
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionsPages), arg0, arg1)
+}
+
+// DescribeAssociationExecutionsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeAssociationExecutionsInput, arg2 func(*ssm.DescribeAssociationExecutionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAssociationExecutionsPagesWithContext indicates an expected call of DescribeAssociationExecutionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionsPagesWithContext), varargs...)
+}
+
+// DescribeAssociationExecutionsRequest mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionsRequest(arg0 *ssm.DescribeAssociationExecutionsInput) (*request.Request, *ssm.DescribeAssociationExecutionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAssociationExecutionsOutput)
+	return ret0, ret1
+}
+
+// DescribeAssociationExecutionsRequest indicates an expected call of DescribeAssociationExecutionsRequest
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionsRequest), arg0)
+}
+
+// DescribeAssociationExecutionsWithContext mocks base method
+func (m *MockSSMClient) DescribeAssociationExecutionsWithContext(arg0 context.Context, arg1 *ssm.DescribeAssociationExecutionsInput, arg2 ...request.Option) (*ssm.DescribeAssociationExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAssociationExecutionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeAssociationExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAssociationExecutionsWithContext indicates an expected call of DescribeAssociationExecutionsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAssociationExecutionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationExecutionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationExecutionsWithContext), varargs...)
+}
+
+// DescribeAssociationRequest mocks base method
+func (m *MockSSMClient) DescribeAssociationRequest(arg0 *ssm.DescribeAssociationInput) (*request.Request, *ssm.DescribeAssociationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAssociationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAssociationOutput)
+	return ret0, ret1
+}
+
+// DescribeAssociationRequest indicates an expected call of DescribeAssociationRequest
+func (mr *MockSSMClientMockRecorder) DescribeAssociationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationRequest), arg0)
+}
+
+// DescribeAssociationWithContext mocks base method
+func (m *MockSSMClient) DescribeAssociationWithContext(arg0 context.Context, arg1 *ssm.DescribeAssociationInput, arg2 ...request.Option) (*ssm.DescribeAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAssociationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAssociationWithContext indicates an expected call of DescribeAssociationWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAssociationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAssociationWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAssociationWithContext), varargs...)
+}
+
+// DescribeAutomationExecutions mocks base method
+func (m *MockSSMClient) DescribeAutomationExecutions(arg0 *ssm.DescribeAutomationExecutionsInput) (*ssm.DescribeAutomationExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutomationExecutions", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeAutomationExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAutomationExecutions indicates an expected call of DescribeAutomationExecutions
+func (mr *MockSSMClientMockRecorder) DescribeAutomationExecutions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationExecutions", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationExecutions), arg0)
+}
+
+// DescribeAutomationExecutionsPages mocks base method
+func (m *MockSSMClient) DescribeAutomationExecutionsPages(arg0 *ssm.DescribeAutomationExecutionsInput, arg1 func(*ssm.DescribeAutomationExecutionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutomationExecutionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAutomationExecutionsPages indicates an expected call of DescribeAutomationExecutionsPages
+func (mr *MockSSMClientMockRecorder) DescribeAutomationExecutionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationExecutionsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationExecutionsPages), arg0, arg1)
+}
+
+// DescribeAutomationExecutionsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeAutomationExecutionsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeAutomationExecutionsInput, arg2 func(*ssm.DescribeAutomationExecutionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAutomationExecutionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAutomationExecutionsPagesWithContext indicates an expected call of DescribeAutomationExecutionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAutomationExecutionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationExecutionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationExecutionsPagesWithContext), varargs...)
+}
+
+// DescribeAutomationExecutionsRequest mocks base method
+func (m *MockSSMClient) DescribeAutomationExecutionsRequest(arg0 *ssm.DescribeAutomationExecutionsInput) (*request.Request, *ssm.DescribeAutomationExecutionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutomationExecutionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAutomationExecutionsOutput)
+	return ret0, ret1
+}
+
+// DescribeAutomationExecutionsRequest indicates an expected call of DescribeAutomationExecutionsRequest
+func (mr *MockSSMClientMockRecorder) DescribeAutomationExecutionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationExecutionsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationExecutionsRequest), arg0)
+}
+
+// DescribeAutomationExecutionsWithContext mocks base method
+func (m *MockSSMClient) DescribeAutomationExecutionsWithContext(arg0 context.Context, arg1 *ssm.DescribeAutomationExecutionsInput, arg2 ...request.Option) (*ssm.DescribeAutomationExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAutomationExecutionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeAutomationExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAutomationExecutionsWithContext indicates an expected call of DescribeAutomationExecutionsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAutomationExecutionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationExecutionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationExecutionsWithContext), varargs...)
+}
+
+// DescribeAutomationStepExecutions mocks base method
+func (m *MockSSMClient) DescribeAutomationStepExecutions(arg0 *ssm.DescribeAutomationStepExecutionsInput) (*ssm.DescribeAutomationStepExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutomationStepExecutions", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeAutomationStepExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAutomationStepExecutions indicates an expected call of DescribeAutomationStepExecutions
+func (mr *MockSSMClientMockRecorder) DescribeAutomationStepExecutions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationStepExecutions", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationStepExecutions), arg0)
+}
+
+// DescribeAutomationStepExecutionsPages mocks base method
+func (m *MockSSMClient) DescribeAutomationStepExecutionsPages(arg0 *ssm.DescribeAutomationStepExecutionsInput, arg1 func(*ssm.DescribeAutomationStepExecutionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutomationStepExecutionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAutomationStepExecutionsPages indicates an expected call of DescribeAutomationStepExecutionsPages
+func (mr *MockSSMClientMockRecorder) DescribeAutomationStepExecutionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationStepExecutionsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationStepExecutionsPages), arg0, arg1)
+}
+
+// DescribeAutomationStepExecutionsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeAutomationStepExecutionsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeAutomationStepExecutionsInput, arg2 func(*ssm.DescribeAutomationStepExecutionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAutomationStepExecutionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAutomationStepExecutionsPagesWithContext indicates an expected call of DescribeAutomationStepExecutionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAutomationStepExecutionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationStepExecutionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationStepExecutionsPagesWithContext), varargs...)
+}
+
+// DescribeAutomationStepExecutionsRequest mocks base method
+func (m *MockSSMClient) DescribeAutomationStepExecutionsRequest(arg0 *ssm.DescribeAutomationStepExecutionsInput) (*request.Request, *ssm.DescribeAutomationStepExecutionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAutomationStepExecutionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAutomationStepExecutionsOutput)
+	return ret0, ret1
+}
+
+// DescribeAutomationStepExecutionsRequest indicates an expected call of DescribeAutomationStepExecutionsRequest
+func (mr *MockSSMClientMockRecorder) DescribeAutomationStepExecutionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationStepExecutionsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationStepExecutionsRequest), arg0)
+}
+
+// DescribeAutomationStepExecutionsWithContext mocks base method
+func (m *MockSSMClient) DescribeAutomationStepExecutionsWithContext(arg0 context.Context, arg1 *ssm.DescribeAutomationStepExecutionsInput, arg2 ...request.Option) (*ssm.DescribeAutomationStepExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAutomationStepExecutionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeAutomationStepExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAutomationStepExecutionsWithContext indicates an expected call of DescribeAutomationStepExecutionsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAutomationStepExecutionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAutomationStepExecutionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAutomationStepExecutionsWithContext), varargs...)
+}
+
+// DescribeAvailablePatches mocks base method
+func (m *MockSSMClient) DescribeAvailablePatches(arg0 *ssm.DescribeAvailablePatchesInput) (*ssm.DescribeAvailablePatchesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAvailablePatches", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeAvailablePatchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAvailablePatches indicates an expected call of DescribeAvailablePatches
+func (mr *MockSSMClientMockRecorder) DescribeAvailablePatches(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailablePatches", reflect.TypeOf((*MockSSMClient)(nil).DescribeAvailablePatches), arg0)
+}
+
+// DescribeAvailablePatchesPages mocks base method
+func (m *MockSSMClient) DescribeAvailablePatchesPages(arg0 *ssm.DescribeAvailablePatchesInput, arg1 func(*ssm.DescribeAvailablePatchesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAvailablePatchesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAvailablePatchesPages indicates an expected call of DescribeAvailablePatchesPages
+func (mr *MockSSMClientMockRecorder) DescribeAvailablePatchesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailablePatchesPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeAvailablePatchesPages), arg0, arg1)
+}
+
+// DescribeAvailablePatchesPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeAvailablePatchesPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeAvailablePatchesInput, arg2 func(*ssm.DescribeAvailablePatchesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAvailablePatchesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeAvailablePatchesPagesWithContext indicates an expected call of DescribeAvailablePatchesPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAvailablePatchesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailablePatchesPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAvailablePatchesPagesWithContext), varargs...)
+}
+
+// DescribeAvailablePatchesRequest mocks base method
+func (m *MockSSMClient) DescribeAvailablePatchesRequest(arg0 *ssm.DescribeAvailablePatchesInput) (*request.Request, *ssm.DescribeAvailablePatchesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAvailablePatchesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeAvailablePatchesOutput)
+	return ret0, ret1
+}
+
+// DescribeAvailablePatchesRequest indicates an expected call of DescribeAvailablePatchesRequest
+func (mr *MockSSMClientMockRecorder) DescribeAvailablePatchesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailablePatchesRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeAvailablePatchesRequest), arg0)
+}
+
+// DescribeAvailablePatchesWithContext mocks base method
+func (m *MockSSMClient) DescribeAvailablePatchesWithContext(arg0 context.Context, arg1 *ssm.DescribeAvailablePatchesInput, arg2 ...request.Option) (*ssm.DescribeAvailablePatchesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAvailablePatchesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeAvailablePatchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAvailablePatchesWithContext indicates an expected call of DescribeAvailablePatchesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeAvailablePatchesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailablePatchesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeAvailablePatchesWithContext), varargs...)
+}
+
+// DescribeDocument mocks base method
+func (m *MockSSMClient) DescribeDocument(arg0 *ssm.DescribeDocumentInput) (*ssm.DescribeDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDocument", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDocument indicates an expected call of DescribeDocument
+func (mr *MockSSMClientMockRecorder) DescribeDocument(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDocument", reflect.TypeOf((*MockSSMClient)(nil).DescribeDocument), arg0)
+}
+
+// DescribeDocumentPermission mocks base method
+func (m *MockSSMClient) DescribeDocumentPermission(arg0 *ssm.DescribeDocumentPermissionInput) (*ssm.DescribeDocumentPermissionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDocumentPermission", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeDocumentPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDocumentPermission indicates an expected call of DescribeDocumentPermission
+func (mr *MockSSMClientMockRecorder) DescribeDocumentPermission(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDocumentPermission", reflect.TypeOf((*MockSSMClient)(nil).DescribeDocumentPermission), arg0)
+}
+
+// DescribeDocumentPermissionRequest mocks base method
+func (m *MockSSMClient) DescribeDocumentPermissionRequest(arg0 *ssm.DescribeDocumentPermissionInput) (*request.Request, *ssm.DescribeDocumentPermissionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDocumentPermissionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeDocumentPermissionOutput)
+	return ret0, ret1
+}
+
+// DescribeDocumentPermissionRequest indicates an expected call of DescribeDocumentPermissionRequest
+func (mr *MockSSMClientMockRecorder) DescribeDocumentPermissionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDocumentPermissionRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeDocumentPermissionRequest), arg0)
+}
+
+// DescribeDocumentPermissionWithContext mocks base method
+func (m *MockSSMClient) DescribeDocumentPermissionWithContext(arg0 context.Context, arg1 *ssm.DescribeDocumentPermissionInput, arg2 ...request.Option) (*ssm.DescribeDocumentPermissionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDocumentPermissionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeDocumentPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDocumentPermissionWithContext indicates an expected call of DescribeDocumentPermissionWithContext
+func (mr *MockSSMClientMockRecorder) DescribeDocumentPermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDocumentPermissionWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeDocumentPermissionWithContext), varargs...)
+}
+
+// DescribeDocumentRequest mocks base method
+func (m *MockSSMClient) DescribeDocumentRequest(arg0 *ssm.DescribeDocumentInput) (*request.Request, *ssm.DescribeDocumentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDocumentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeDocumentOutput)
+	return ret0, ret1
+}
+
+// DescribeDocumentRequest indicates an expected call of DescribeDocumentRequest
+func (mr *MockSSMClientMockRecorder) DescribeDocumentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDocumentRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeDocumentRequest), arg0)
+}
+
+// DescribeDocumentWithContext mocks base method
+func (m *MockSSMClient) DescribeDocumentWithContext(arg0 context.Context, arg1 *ssm.DescribeDocumentInput, arg2 ...request.Option) (*ssm.DescribeDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDocumentWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDocumentWithContext indicates an expected call of DescribeDocumentWithContext
+func (mr *MockSSMClientMockRecorder) DescribeDocumentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDocumentWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeDocumentWithContext), varargs...)
+}
+
+// DescribeEffectiveInstanceAssociations mocks base method
+func (m *MockSSMClient) DescribeEffectiveInstanceAssociations(arg0 *ssm.DescribeEffectiveInstanceAssociationsInput) (*ssm.DescribeEffectiveInstanceAssociationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEffectiveInstanceAssociations", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeEffectiveInstanceAssociationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEffectiveInstanceAssociations indicates an expected call of DescribeEffectiveInstanceAssociations
+func (mr *MockSSMClientMockRecorder) DescribeEffectiveInstanceAssociations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectiveInstanceAssociations", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectiveInstanceAssociations), arg0)
+}
+
+// DescribeEffectiveInstanceAssociationsPages mocks base method
+func (m *MockSSMClient) DescribeEffectiveInstanceAssociationsPages(arg0 *ssm.DescribeEffectiveInstanceAssociationsInput, arg1 func(*ssm.DescribeEffectiveInstanceAssociationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEffectiveInstanceAssociationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeEffectiveInstanceAssociationsPages indicates an expected call of DescribeEffectiveInstanceAssociationsPages
+func (mr *MockSSMClientMockRecorder) DescribeEffectiveInstanceAssociationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectiveInstanceAssociationsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectiveInstanceAssociationsPages), arg0, arg1)
+}
+
+// DescribeEffectiveInstanceAssociationsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeEffectiveInstanceAssociationsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeEffectiveInstanceAssociationsInput, arg2 func(*ssm.DescribeEffectiveInstanceAssociationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEffectiveInstanceAssociationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeEffectiveInstanceAssociationsPagesWithContext indicates an expected call of DescribeEffectiveInstanceAssociationsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeEffectiveInstanceAssociationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectiveInstanceAssociationsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectiveInstanceAssociationsPagesWithContext), varargs...)
+}
+
+// DescribeEffectiveInstanceAssociationsRequest mocks base method
+func (m *MockSSMClient) DescribeEffectiveInstanceAssociationsRequest(arg0 *ssm.DescribeEffectiveInstanceAssociationsInput) (*request.Request, *ssm.DescribeEffectiveInstanceAssociationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEffectiveInstanceAssociationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeEffectiveInstanceAssociationsOutput)
+	return ret0, ret1
+}
+
+// DescribeEffectiveInstanceAssociationsRequest indicates an expected call of DescribeEffectiveInstanceAssociationsRequest
+func (mr *MockSSMClientMockRecorder) DescribeEffectiveInstanceAssociationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectiveInstanceAssociationsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectiveInstanceAssociationsRequest), arg0)
+}
+
+// DescribeEffectiveInstanceAssociationsWithContext mocks base method
+func (m *MockSSMClient) DescribeEffectiveInstanceAssociationsWithContext(arg0 context.Context, arg1 *ssm.DescribeEffectiveInstanceAssociationsInput, arg2 ...request.Option) (*ssm.DescribeEffectiveInstanceAssociationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEffectiveInstanceAssociationsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeEffectiveInstanceAssociationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEffectiveInstanceAssociationsWithContext indicates an expected call of DescribeEffectiveInstanceAssociationsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeEffectiveInstanceAssociationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectiveInstanceAssociationsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectiveInstanceAssociationsWithContext), varargs...)
+}
+
+// DescribeEffectivePatchesForPatchBaseline mocks base method
+func (m *MockSSMClient) DescribeEffectivePatchesForPatchBaseline(arg0 *ssm.DescribeEffectivePatchesForPatchBaselineInput) (*ssm.DescribeEffectivePatchesForPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEffectivePatchesForPatchBaseline", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeEffectivePatchesForPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEffectivePatchesForPatchBaseline indicates an expected call of DescribeEffectivePatchesForPatchBaseline
+func (mr *MockSSMClientMockRecorder) DescribeEffectivePatchesForPatchBaseline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectivePatchesForPatchBaseline", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectivePatchesForPatchBaseline), arg0)
+}
+
+// DescribeEffectivePatchesForPatchBaselinePages mocks base method
+func (m *MockSSMClient) DescribeEffectivePatchesForPatchBaselinePages(arg0 *ssm.DescribeEffectivePatchesForPatchBaselineInput, arg1 func(*ssm.DescribeEffectivePatchesForPatchBaselineOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEffectivePatchesForPatchBaselinePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeEffectivePatchesForPatchBaselinePages indicates an expected call of DescribeEffectivePatchesForPatchBaselinePages
+func (mr *MockSSMClientMockRecorder) DescribeEffectivePatchesForPatchBaselinePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectivePatchesForPatchBaselinePages", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectivePatchesForPatchBaselinePages), arg0, arg1)
+}
+
+// DescribeEffectivePatchesForPatchBaselinePagesWithContext mocks base method
+func (m *MockSSMClient) DescribeEffectivePatchesForPatchBaselinePagesWithContext(arg0 context.Context, arg1 *ssm.DescribeEffectivePatchesForPatchBaselineInput, arg2 func(*ssm.DescribeEffectivePatchesForPatchBaselineOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEffectivePatchesForPatchBaselinePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeEffectivePatchesForPatchBaselinePagesWithContext indicates an expected call of DescribeEffectivePatchesForPatchBaselinePagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeEffectivePatchesForPatchBaselinePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectivePatchesForPatchBaselinePagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectivePatchesForPatchBaselinePagesWithContext), varargs...)
+}
+
+// DescribeEffectivePatchesForPatchBaselineRequest mocks base method
+func (m *MockSSMClient) DescribeEffectivePatchesForPatchBaselineRequest(arg0 *ssm.DescribeEffectivePatchesForPatchBaselineInput) (*request.Request, *ssm.DescribeEffectivePatchesForPatchBaselineOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEffectivePatchesForPatchBaselineRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeEffectivePatchesForPatchBaselineOutput)
+	return ret0, ret1
+}
+
+// DescribeEffectivePatchesForPatchBaselineRequest indicates an expected call of DescribeEffectivePatchesForPatchBaselineRequest
+func (mr *MockSSMClientMockRecorder) DescribeEffectivePatchesForPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectivePatchesForPatchBaselineRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectivePatchesForPatchBaselineRequest), arg0)
+}
+
+// DescribeEffectivePatchesForPatchBaselineWithContext mocks base method
+func (m *MockSSMClient) DescribeEffectivePatchesForPatchBaselineWithContext(arg0 context.Context, arg1 *ssm.DescribeEffectivePatchesForPatchBaselineInput, arg2 ...request.Option) (*ssm.DescribeEffectivePatchesForPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEffectivePatchesForPatchBaselineWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeEffectivePatchesForPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEffectivePatchesForPatchBaselineWithContext indicates an expected call of DescribeEffectivePatchesForPatchBaselineWithContext
+func (mr *MockSSMClientMockRecorder) DescribeEffectivePatchesForPatchBaselineWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEffectivePatchesForPatchBaselineWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeEffectivePatchesForPatchBaselineWithContext), varargs...)
+}
+
+// DescribeInstanceAssociationsStatus mocks base method
+func (m *MockSSMClient) DescribeInstanceAssociationsStatus(arg0 *ssm.DescribeInstanceAssociationsStatusInput) (*ssm.DescribeInstanceAssociationsStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceAssociationsStatus", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeInstanceAssociationsStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceAssociationsStatus indicates an expected call of DescribeInstanceAssociationsStatus
+func (mr *MockSSMClientMockRecorder) DescribeInstanceAssociationsStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceAssociationsStatus", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceAssociationsStatus), arg0)
+}
+
+// DescribeInstanceAssociationsStatusPages mocks base method
+func (m *MockSSMClient) DescribeInstanceAssociationsStatusPages(arg0 *ssm.DescribeInstanceAssociationsStatusInput, arg1 func(*ssm.DescribeInstanceAssociationsStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceAssociationsStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstanceAssociationsStatusPages indicates an expected call of DescribeInstanceAssociationsStatusPages
+func (mr *MockSSMClientMockRecorder) DescribeInstanceAssociationsStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceAssociationsStatusPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceAssociationsStatusPages), arg0, arg1)
+}
+
+// DescribeInstanceAssociationsStatusPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeInstanceAssociationsStatusPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeInstanceAssociationsStatusInput, arg2 func(*ssm.DescribeInstanceAssociationsStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstanceAssociationsStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstanceAssociationsStatusPagesWithContext indicates an expected call of DescribeInstanceAssociationsStatusPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstanceAssociationsStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceAssociationsStatusPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceAssociationsStatusPagesWithContext), varargs...)
+}
+
+// DescribeInstanceAssociationsStatusRequest mocks base method
+func (m *MockSSMClient) DescribeInstanceAssociationsStatusRequest(arg0 *ssm.DescribeInstanceAssociationsStatusInput) (*request.Request, *ssm.DescribeInstanceAssociationsStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceAssociationsStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstanceAssociationsStatusOutput)
+	return ret0, ret1
+}
+
+// DescribeInstanceAssociationsStatusRequest indicates an expected call of DescribeInstanceAssociationsStatusRequest
+func (mr *MockSSMClientMockRecorder) DescribeInstanceAssociationsStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceAssociationsStatusRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceAssociationsStatusRequest), arg0)
+}
+
+// DescribeInstanceAssociationsStatusWithContext mocks base method
+func (m *MockSSMClient) DescribeInstanceAssociationsStatusWithContext(arg0 context.Context, arg1 *ssm.DescribeInstanceAssociationsStatusInput, arg2 ...request.Option) (*ssm.DescribeInstanceAssociationsStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstanceAssociationsStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeInstanceAssociationsStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceAssociationsStatusWithContext indicates an expected call of DescribeInstanceAssociationsStatusWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstanceAssociationsStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceAssociationsStatusWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceAssociationsStatusWithContext), varargs...)
+}
+
+// DescribeInstanceInformation mocks base method
+func (m *MockSSMClient) DescribeInstanceInformation(arg0 *ssm.DescribeInstanceInformationInput) (*ssm.DescribeInstanceInformationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceInformation", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeInstanceInformationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceInformation indicates an expected call of DescribeInstanceInformation
+func (mr *MockSSMClientMockRecorder) DescribeInstanceInformation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceInformation", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceInformation), arg0)
+}
+
+// DescribeInstanceInformationPages mocks base method
+func (m *MockSSMClient) DescribeInstanceInformationPages(arg0 *ssm.DescribeInstanceInformationInput, arg1 func(*ssm.DescribeInstanceInformationOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceInformationPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstanceInformationPages indicates an expected call of DescribeInstanceInformationPages
+func (mr *MockSSMClientMockRecorder) DescribeInstanceInformationPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceInformationPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceInformationPages), arg0, arg1)
+}
+
+// DescribeInstanceInformationPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeInstanceInformationPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeInstanceInformationInput, arg2 func(*ssm.DescribeInstanceInformationOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstanceInformationPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstanceInformationPagesWithContext indicates an expected call of DescribeInstanceInformationPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstanceInformationPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceInformationPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceInformationPagesWithContext), varargs...)
+}
+
+// DescribeInstanceInformationRequest mocks base method
+func (m *MockSSMClient) DescribeInstanceInformationRequest(arg0 *ssm.DescribeInstanceInformationInput) (*request.Request, *ssm.DescribeInstanceInformationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstanceInformationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstanceInformationOutput)
+	return ret0, ret1
+}
+
+// DescribeInstanceInformationRequest indicates an expected call of DescribeInstanceInformationRequest
+func (mr *MockSSMClientMockRecorder) DescribeInstanceInformationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceInformationRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceInformationRequest), arg0)
+}
+
+// DescribeInstanceInformationWithContext mocks base method
+func (m *MockSSMClient) DescribeInstanceInformationWithContext(arg0 context.Context, arg1 *ssm.DescribeInstanceInformationInput, arg2 ...request.Option) (*ssm.DescribeInstanceInformationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstanceInformationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeInstanceInformationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstanceInformationWithContext indicates an expected call of DescribeInstanceInformationWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstanceInformationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceInformationWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstanceInformationWithContext), varargs...)
+}
+
+// DescribeInstancePatchStates mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStates(arg0 *ssm.DescribeInstancePatchStatesInput) (*ssm.DescribeInstancePatchStatesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStates", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchStatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchStates indicates an expected call of DescribeInstancePatchStates
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStates", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStates), arg0)
+}
+
+// DescribeInstancePatchStatesForPatchGroup mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesForPatchGroup(arg0 *ssm.DescribeInstancePatchStatesForPatchGroupInput) (*ssm.DescribeInstancePatchStatesForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesForPatchGroup", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchStatesForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchStatesForPatchGroup indicates an expected call of DescribeInstancePatchStatesForPatchGroup
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesForPatchGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesForPatchGroup", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesForPatchGroup), arg0)
+}
+
+// DescribeInstancePatchStatesForPatchGroupPages mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesForPatchGroupPages(arg0 *ssm.DescribeInstancePatchStatesForPatchGroupInput, arg1 func(*ssm.DescribeInstancePatchStatesForPatchGroupOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesForPatchGroupPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstancePatchStatesForPatchGroupPages indicates an expected call of DescribeInstancePatchStatesForPatchGroupPages
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesForPatchGroupPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesForPatchGroupPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesForPatchGroupPages), arg0, arg1)
+}
+
+// DescribeInstancePatchStatesForPatchGroupPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesForPatchGroupPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeInstancePatchStatesForPatchGroupInput, arg2 func(*ssm.DescribeInstancePatchStatesForPatchGroupOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesForPatchGroupPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstancePatchStatesForPatchGroupPagesWithContext indicates an expected call of DescribeInstancePatchStatesForPatchGroupPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesForPatchGroupPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesForPatchGroupPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesForPatchGroupPagesWithContext), varargs...)
+}
+
+// DescribeInstancePatchStatesForPatchGroupRequest mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesForPatchGroupRequest(arg0 *ssm.DescribeInstancePatchStatesForPatchGroupInput) (*request.Request, *ssm.DescribeInstancePatchStatesForPatchGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesForPatchGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstancePatchStatesForPatchGroupOutput)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchStatesForPatchGroupRequest indicates an expected call of DescribeInstancePatchStatesForPatchGroupRequest
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesForPatchGroupRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesForPatchGroupRequest), arg0)
+}
+
+// DescribeInstancePatchStatesForPatchGroupWithContext mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesForPatchGroupWithContext(arg0 context.Context, arg1 *ssm.DescribeInstancePatchStatesForPatchGroupInput, arg2 ...request.Option) (*ssm.DescribeInstancePatchStatesForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesForPatchGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchStatesForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchStatesForPatchGroupWithContext indicates an expected call of DescribeInstancePatchStatesForPatchGroupWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesForPatchGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesForPatchGroupWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesForPatchGroupWithContext), varargs...)
+}
+
+// DescribeInstancePatchStatesPages mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesPages(arg0 *ssm.DescribeInstancePatchStatesInput, arg1 func(*ssm.DescribeInstancePatchStatesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstancePatchStatesPages indicates an expected call of DescribeInstancePatchStatesPages
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesPages), arg0, arg1)
+}
+
+// DescribeInstancePatchStatesPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeInstancePatchStatesInput, arg2 func(*ssm.DescribeInstancePatchStatesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstancePatchStatesPagesWithContext indicates an expected call of DescribeInstancePatchStatesPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesPagesWithContext), varargs...)
+}
+
+// DescribeInstancePatchStatesRequest mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesRequest(arg0 *ssm.DescribeInstancePatchStatesInput) (*request.Request, *ssm.DescribeInstancePatchStatesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstancePatchStatesOutput)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchStatesRequest indicates an expected call of DescribeInstancePatchStatesRequest
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesRequest), arg0)
+}
+
+// DescribeInstancePatchStatesWithContext mocks base method
+func (m *MockSSMClient) DescribeInstancePatchStatesWithContext(arg0 context.Context, arg1 *ssm.DescribeInstancePatchStatesInput, arg2 ...request.Option) (*ssm.DescribeInstancePatchStatesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstancePatchStatesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchStatesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchStatesWithContext indicates an expected call of DescribeInstancePatchStatesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchStatesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchStatesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchStatesWithContext), varargs...)
+}
+
+// DescribeInstancePatches mocks base method
+func (m *MockSSMClient) DescribeInstancePatches(arg0 *ssm.DescribeInstancePatchesInput) (*ssm.DescribeInstancePatchesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatches", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstancePatches indicates an expected call of DescribeInstancePatches
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatches(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatches", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatches), arg0)
+}
+
+// DescribeInstancePatchesPages mocks base method
+func (m *MockSSMClient) DescribeInstancePatchesPages(arg0 *ssm.DescribeInstancePatchesInput, arg1 func(*ssm.DescribeInstancePatchesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstancePatchesPages indicates an expected call of DescribeInstancePatchesPages
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchesPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchesPages), arg0, arg1)
+}
+
+// DescribeInstancePatchesPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeInstancePatchesPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeInstancePatchesInput, arg2 func(*ssm.DescribeInstancePatchesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstancePatchesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInstancePatchesPagesWithContext indicates an expected call of DescribeInstancePatchesPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchesPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchesPagesWithContext), varargs...)
+}
+
+// DescribeInstancePatchesRequest mocks base method
+func (m *MockSSMClient) DescribeInstancePatchesRequest(arg0 *ssm.DescribeInstancePatchesInput) (*request.Request, *ssm.DescribeInstancePatchesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancePatchesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInstancePatchesOutput)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchesRequest indicates an expected call of DescribeInstancePatchesRequest
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchesRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchesRequest), arg0)
+}
+
+// DescribeInstancePatchesWithContext mocks base method
+func (m *MockSSMClient) DescribeInstancePatchesWithContext(arg0 context.Context, arg1 *ssm.DescribeInstancePatchesInput, arg2 ...request.Option) (*ssm.DescribeInstancePatchesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInstancePatchesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeInstancePatchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstancePatchesWithContext indicates an expected call of DescribeInstancePatchesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInstancePatchesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancePatchesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInstancePatchesWithContext), varargs...)
+}
+
+// DescribeInventoryDeletions mocks base method
+func (m *MockSSMClient) DescribeInventoryDeletions(arg0 *ssm.DescribeInventoryDeletionsInput) (*ssm.DescribeInventoryDeletionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInventoryDeletions", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeInventoryDeletionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInventoryDeletions indicates an expected call of DescribeInventoryDeletions
+func (mr *MockSSMClientMockRecorder) DescribeInventoryDeletions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInventoryDeletions", reflect.TypeOf((*MockSSMClient)(nil).DescribeInventoryDeletions), arg0)
+}
+
+// DescribeInventoryDeletionsPages mocks base method
+func (m *MockSSMClient) DescribeInventoryDeletionsPages(arg0 *ssm.DescribeInventoryDeletionsInput, arg1 func(*ssm.DescribeInventoryDeletionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInventoryDeletionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInventoryDeletionsPages indicates an expected call of DescribeInventoryDeletionsPages
+func (mr *MockSSMClientMockRecorder) DescribeInventoryDeletionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInventoryDeletionsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeInventoryDeletionsPages), arg0, arg1)
+}
+
+// DescribeInventoryDeletionsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeInventoryDeletionsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeInventoryDeletionsInput, arg2 func(*ssm.DescribeInventoryDeletionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInventoryDeletionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeInventoryDeletionsPagesWithContext indicates an expected call of DescribeInventoryDeletionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInventoryDeletionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInventoryDeletionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInventoryDeletionsPagesWithContext), varargs...)
+}
+
+// DescribeInventoryDeletionsRequest mocks base method
+func (m *MockSSMClient) DescribeInventoryDeletionsRequest(arg0 *ssm.DescribeInventoryDeletionsInput) (*request.Request, *ssm.DescribeInventoryDeletionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInventoryDeletionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeInventoryDeletionsOutput)
+	return ret0, ret1
+}
+
+// DescribeInventoryDeletionsRequest indicates an expected call of DescribeInventoryDeletionsRequest
+func (mr *MockSSMClientMockRecorder) DescribeInventoryDeletionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInventoryDeletionsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeInventoryDeletionsRequest), arg0)
+}
+
+// DescribeInventoryDeletionsWithContext mocks base method
+func (m *MockSSMClient) DescribeInventoryDeletionsWithContext(arg0 context.Context, arg1 *ssm.DescribeInventoryDeletionsInput, arg2 ...request.Option) (*ssm.DescribeInventoryDeletionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeInventoryDeletionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeInventoryDeletionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInventoryDeletionsWithContext indicates an expected call of DescribeInventoryDeletionsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeInventoryDeletionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInventoryDeletionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeInventoryDeletionsWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocations mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTaskInvocations(arg0 *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsInput) (*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTaskInvocations", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocations indicates an expected call of DescribeMaintenanceWindowExecutionTaskInvocations
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTaskInvocations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTaskInvocations", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTaskInvocations), arg0)
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsPages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTaskInvocationsPages(arg0 *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsInput, arg1 func(*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTaskInvocationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsPages indicates an expected call of DescribeMaintenanceWindowExecutionTaskInvocationsPages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTaskInvocationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTaskInvocationsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTaskInvocationsPages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsInput, arg2 func(*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext indicates an expected call of DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTaskInvocationsPagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTaskInvocationsRequest(arg0 *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsInput) (*request.Request, *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTaskInvocationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsRequest indicates an expected call of DescribeMaintenanceWindowExecutionTaskInvocationsRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTaskInvocationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTaskInvocationsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTaskInvocationsRequest), arg0)
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTaskInvocationsWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowExecutionTaskInvocationsInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTaskInvocationsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionTaskInvocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionTaskInvocationsWithContext indicates an expected call of DescribeMaintenanceWindowExecutionTaskInvocationsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTaskInvocationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTaskInvocationsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTaskInvocationsWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowExecutionTasks mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTasks(arg0 *ssm.DescribeMaintenanceWindowExecutionTasksInput) (*ssm.DescribeMaintenanceWindowExecutionTasksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTasks", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionTasks indicates an expected call of DescribeMaintenanceWindowExecutionTasks
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTasks", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTasks), arg0)
+}
+
+// DescribeMaintenanceWindowExecutionTasksPages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTasksPages(arg0 *ssm.DescribeMaintenanceWindowExecutionTasksInput, arg1 func(*ssm.DescribeMaintenanceWindowExecutionTasksOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTasksPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowExecutionTasksPages indicates an expected call of DescribeMaintenanceWindowExecutionTasksPages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTasksPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTasksPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTasksPages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowExecutionTasksPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTasksPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowExecutionTasksInput, arg2 func(*ssm.DescribeMaintenanceWindowExecutionTasksOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTasksPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowExecutionTasksPagesWithContext indicates an expected call of DescribeMaintenanceWindowExecutionTasksPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTasksPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTasksPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTasksPagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowExecutionTasksRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTasksRequest(arg0 *ssm.DescribeMaintenanceWindowExecutionTasksInput) (*request.Request, *ssm.DescribeMaintenanceWindowExecutionTasksOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTasksRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowExecutionTasksOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionTasksRequest indicates an expected call of DescribeMaintenanceWindowExecutionTasksRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTasksRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTasksRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTasksRequest), arg0)
+}
+
+// DescribeMaintenanceWindowExecutionTasksWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionTasksWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowExecutionTasksInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowExecutionTasksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionTasksWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionTasksWithContext indicates an expected call of DescribeMaintenanceWindowExecutionTasksWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionTasksWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionTasksWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowExecutions mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutions(arg0 *ssm.DescribeMaintenanceWindowExecutionsInput) (*ssm.DescribeMaintenanceWindowExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutions", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutions indicates an expected call of DescribeMaintenanceWindowExecutions
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutions", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutions), arg0)
+}
+
+// DescribeMaintenanceWindowExecutionsPages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionsPages(arg0 *ssm.DescribeMaintenanceWindowExecutionsInput, arg1 func(*ssm.DescribeMaintenanceWindowExecutionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowExecutionsPages indicates an expected call of DescribeMaintenanceWindowExecutionsPages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionsPages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowExecutionsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowExecutionsInput, arg2 func(*ssm.DescribeMaintenanceWindowExecutionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowExecutionsPagesWithContext indicates an expected call of DescribeMaintenanceWindowExecutionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionsPagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowExecutionsRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionsRequest(arg0 *ssm.DescribeMaintenanceWindowExecutionsInput) (*request.Request, *ssm.DescribeMaintenanceWindowExecutionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowExecutionsOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionsRequest indicates an expected call of DescribeMaintenanceWindowExecutionsRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionsRequest), arg0)
+}
+
+// DescribeMaintenanceWindowExecutionsWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowExecutionsWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowExecutionsInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowExecutionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowExecutionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowExecutionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowExecutionsWithContext indicates an expected call of DescribeMaintenanceWindowExecutionsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowExecutionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowExecutionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowExecutionsWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowSchedule mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowSchedule(arg0 *ssm.DescribeMaintenanceWindowScheduleInput) (*ssm.DescribeMaintenanceWindowScheduleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowSchedule", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowScheduleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowSchedule indicates an expected call of DescribeMaintenanceWindowSchedule
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowSchedule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowSchedule", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowSchedule), arg0)
+}
+
+// DescribeMaintenanceWindowSchedulePages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowSchedulePages(arg0 *ssm.DescribeMaintenanceWindowScheduleInput, arg1 func(*ssm.DescribeMaintenanceWindowScheduleOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowSchedulePages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowSchedulePages indicates an expected call of DescribeMaintenanceWindowSchedulePages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowSchedulePages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowSchedulePages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowSchedulePages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowSchedulePagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowSchedulePagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowScheduleInput, arg2 func(*ssm.DescribeMaintenanceWindowScheduleOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowSchedulePagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowSchedulePagesWithContext indicates an expected call of DescribeMaintenanceWindowSchedulePagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowSchedulePagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowSchedulePagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowSchedulePagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowScheduleRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowScheduleRequest(arg0 *ssm.DescribeMaintenanceWindowScheduleInput) (*request.Request, *ssm.DescribeMaintenanceWindowScheduleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowScheduleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowScheduleOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowScheduleRequest indicates an expected call of DescribeMaintenanceWindowScheduleRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowScheduleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowScheduleRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowScheduleRequest), arg0)
+}
+
+// DescribeMaintenanceWindowScheduleWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowScheduleWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowScheduleInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowScheduleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowScheduleWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowScheduleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowScheduleWithContext indicates an expected call of DescribeMaintenanceWindowScheduleWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowScheduleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowScheduleWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowScheduleWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowTargets mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTargets(arg0 *ssm.DescribeMaintenanceWindowTargetsInput) (*ssm.DescribeMaintenanceWindowTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTargets", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowTargets indicates an expected call of DescribeMaintenanceWindowTargets
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTargets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTargets", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTargets), arg0)
+}
+
+// DescribeMaintenanceWindowTargetsPages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTargetsPages(arg0 *ssm.DescribeMaintenanceWindowTargetsInput, arg1 func(*ssm.DescribeMaintenanceWindowTargetsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTargetsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowTargetsPages indicates an expected call of DescribeMaintenanceWindowTargetsPages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTargetsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTargetsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTargetsPages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowTargetsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTargetsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowTargetsInput, arg2 func(*ssm.DescribeMaintenanceWindowTargetsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTargetsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowTargetsPagesWithContext indicates an expected call of DescribeMaintenanceWindowTargetsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTargetsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTargetsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTargetsPagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowTargetsRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTargetsRequest(arg0 *ssm.DescribeMaintenanceWindowTargetsInput) (*request.Request, *ssm.DescribeMaintenanceWindowTargetsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTargetsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowTargetsOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowTargetsRequest indicates an expected call of DescribeMaintenanceWindowTargetsRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTargetsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTargetsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTargetsRequest), arg0)
+}
+
+// DescribeMaintenanceWindowTargetsWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTargetsWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowTargetsInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTargetsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowTargetsWithContext indicates an expected call of DescribeMaintenanceWindowTargetsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTargetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTargetsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTargetsWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowTasks mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTasks(arg0 *ssm.DescribeMaintenanceWindowTasksInput) (*ssm.DescribeMaintenanceWindowTasksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTasks", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowTasks indicates an expected call of DescribeMaintenanceWindowTasks
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTasks", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTasks), arg0)
+}
+
+// DescribeMaintenanceWindowTasksPages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTasksPages(arg0 *ssm.DescribeMaintenanceWindowTasksInput, arg1 func(*ssm.DescribeMaintenanceWindowTasksOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTasksPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowTasksPages indicates an expected call of DescribeMaintenanceWindowTasksPages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTasksPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTasksPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTasksPages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowTasksPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTasksPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowTasksInput, arg2 func(*ssm.DescribeMaintenanceWindowTasksOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTasksPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowTasksPagesWithContext indicates an expected call of DescribeMaintenanceWindowTasksPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTasksPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTasksPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTasksPagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowTasksRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTasksRequest(arg0 *ssm.DescribeMaintenanceWindowTasksInput) (*request.Request, *ssm.DescribeMaintenanceWindowTasksOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTasksRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowTasksOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowTasksRequest indicates an expected call of DescribeMaintenanceWindowTasksRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTasksRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTasksRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTasksRequest), arg0)
+}
+
+// DescribeMaintenanceWindowTasksWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowTasksWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowTasksInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowTasksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowTasksWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowTasksWithContext indicates an expected call of DescribeMaintenanceWindowTasksWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowTasksWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowTasksWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindows mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindows(arg0 *ssm.DescribeMaintenanceWindowsInput) (*ssm.DescribeMaintenanceWindowsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindows", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindows indicates an expected call of DescribeMaintenanceWindows
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindows(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindows", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindows), arg0)
+}
+
+// DescribeMaintenanceWindowsForTarget mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsForTarget(arg0 *ssm.DescribeMaintenanceWindowsForTargetInput) (*ssm.DescribeMaintenanceWindowsForTargetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsForTarget", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowsForTargetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowsForTarget indicates an expected call of DescribeMaintenanceWindowsForTarget
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsForTarget(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsForTarget", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsForTarget), arg0)
+}
+
+// DescribeMaintenanceWindowsForTargetPages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsForTargetPages(arg0 *ssm.DescribeMaintenanceWindowsForTargetInput, arg1 func(*ssm.DescribeMaintenanceWindowsForTargetOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsForTargetPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowsForTargetPages indicates an expected call of DescribeMaintenanceWindowsForTargetPages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsForTargetPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsForTargetPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsForTargetPages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowsForTargetPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsForTargetPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowsForTargetInput, arg2 func(*ssm.DescribeMaintenanceWindowsForTargetOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsForTargetPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowsForTargetPagesWithContext indicates an expected call of DescribeMaintenanceWindowsForTargetPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsForTargetPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsForTargetPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsForTargetPagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowsForTargetRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsForTargetRequest(arg0 *ssm.DescribeMaintenanceWindowsForTargetInput) (*request.Request, *ssm.DescribeMaintenanceWindowsForTargetOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsForTargetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowsForTargetOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowsForTargetRequest indicates an expected call of DescribeMaintenanceWindowsForTargetRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsForTargetRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsForTargetRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsForTargetRequest), arg0)
+}
+
+// DescribeMaintenanceWindowsForTargetWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsForTargetWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowsForTargetInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowsForTargetOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsForTargetWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowsForTargetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowsForTargetWithContext indicates an expected call of DescribeMaintenanceWindowsForTargetWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsForTargetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsForTargetWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsForTargetWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowsPages mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsPages(arg0 *ssm.DescribeMaintenanceWindowsInput, arg1 func(*ssm.DescribeMaintenanceWindowsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowsPages indicates an expected call of DescribeMaintenanceWindowsPages
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsPages), arg0, arg1)
+}
+
+// DescribeMaintenanceWindowsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowsInput, arg2 func(*ssm.DescribeMaintenanceWindowsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeMaintenanceWindowsPagesWithContext indicates an expected call of DescribeMaintenanceWindowsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsPagesWithContext), varargs...)
+}
+
+// DescribeMaintenanceWindowsRequest mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsRequest(arg0 *ssm.DescribeMaintenanceWindowsInput) (*request.Request, *ssm.DescribeMaintenanceWindowsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeMaintenanceWindowsOutput)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowsRequest indicates an expected call of DescribeMaintenanceWindowsRequest
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsRequest), arg0)
+}
+
+// DescribeMaintenanceWindowsWithContext mocks base method
+func (m *MockSSMClient) DescribeMaintenanceWindowsWithContext(arg0 context.Context, arg1 *ssm.DescribeMaintenanceWindowsInput, arg2 ...request.Option) (*ssm.DescribeMaintenanceWindowsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMaintenanceWindowsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeMaintenanceWindowsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMaintenanceWindowsWithContext indicates an expected call of DescribeMaintenanceWindowsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeMaintenanceWindowsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMaintenanceWindowsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeMaintenanceWindowsWithContext), varargs...)
+}
+
+// DescribeOpsItems mocks base method
+func (m *MockSSMClient) DescribeOpsItems(arg0 *ssm.DescribeOpsItemsInput) (*ssm.DescribeOpsItemsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOpsItems", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeOpsItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOpsItems indicates an expected call of DescribeOpsItems
+func (mr *MockSSMClientMockRecorder) DescribeOpsItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOpsItems", reflect.TypeOf((*MockSSMClient)(nil).DescribeOpsItems), arg0)
+}
+
+// DescribeOpsItemsPages mocks base method
+func (m *MockSSMClient) DescribeOpsItemsPages(arg0 *ssm.DescribeOpsItemsInput, arg1 func(*ssm.DescribeOpsItemsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOpsItemsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOpsItemsPages indicates an expected call of DescribeOpsItemsPages
+func (mr *MockSSMClientMockRecorder) DescribeOpsItemsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOpsItemsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeOpsItemsPages), arg0, arg1)
+}
+
+// DescribeOpsItemsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeOpsItemsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeOpsItemsInput, arg2 func(*ssm.DescribeOpsItemsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOpsItemsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeOpsItemsPagesWithContext indicates an expected call of DescribeOpsItemsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeOpsItemsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOpsItemsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeOpsItemsPagesWithContext), varargs...)
+}
+
+// DescribeOpsItemsRequest mocks base method
+func (m *MockSSMClient) DescribeOpsItemsRequest(arg0 *ssm.DescribeOpsItemsInput) (*request.Request, *ssm.DescribeOpsItemsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOpsItemsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeOpsItemsOutput)
+	return ret0, ret1
+}
+
+// DescribeOpsItemsRequest indicates an expected call of DescribeOpsItemsRequest
+func (mr *MockSSMClientMockRecorder) DescribeOpsItemsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOpsItemsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeOpsItemsRequest), arg0)
+}
+
+// DescribeOpsItemsWithContext mocks base method
+func (m *MockSSMClient) DescribeOpsItemsWithContext(arg0 context.Context, arg1 *ssm.DescribeOpsItemsInput, arg2 ...request.Option) (*ssm.DescribeOpsItemsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOpsItemsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeOpsItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOpsItemsWithContext indicates an expected call of DescribeOpsItemsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeOpsItemsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOpsItemsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeOpsItemsWithContext), varargs...)
+}
+
+// DescribeParameters mocks base method
+func (m *MockSSMClient) DescribeParameters(arg0 *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeParameters", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeParameters indicates an expected call of DescribeParameters
+func (mr *MockSSMClientMockRecorder) DescribeParameters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeParameters", reflect.TypeOf((*MockSSMClient)(nil).DescribeParameters), arg0)
+}
+
+// DescribeParametersPages mocks base method
+func (m *MockSSMClient) DescribeParametersPages(arg0 *ssm.DescribeParametersInput, arg1 func(*ssm.DescribeParametersOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeParametersPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeParametersPages indicates an expected call of DescribeParametersPages
+func (mr *MockSSMClientMockRecorder) DescribeParametersPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeParametersPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeParametersPages), arg0, arg1)
+}
+
+// DescribeParametersPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeParametersPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeParametersInput, arg2 func(*ssm.DescribeParametersOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeParametersPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeParametersPagesWithContext indicates an expected call of DescribeParametersPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeParametersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeParametersPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeParametersPagesWithContext), varargs...)
+}
+
+// DescribeParametersRequest mocks base method
+func (m *MockSSMClient) DescribeParametersRequest(arg0 *ssm.DescribeParametersInput) (*request.Request, *ssm.DescribeParametersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeParametersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeParametersOutput)
+	return ret0, ret1
+}
+
+// DescribeParametersRequest indicates an expected call of DescribeParametersRequest
+func (mr *MockSSMClientMockRecorder) DescribeParametersRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeParametersRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeParametersRequest), arg0)
+}
+
+// DescribeParametersWithContext mocks base method
+func (m *MockSSMClient) DescribeParametersWithContext(arg0 context.Context, arg1 *ssm.DescribeParametersInput, arg2 ...request.Option) (*ssm.DescribeParametersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeParametersWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeParametersWithContext indicates an expected call of DescribeParametersWithContext
+func (mr *MockSSMClientMockRecorder) DescribeParametersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeParametersWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeParametersWithContext), varargs...)
+}
+
+// DescribePatchBaselines mocks base method
+func (m *MockSSMClient) DescribePatchBaselines(arg0 *ssm.DescribePatchBaselinesInput) (*ssm.DescribePatchBaselinesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchBaselines", arg0)
+	ret0, _ := ret[0].(*ssm.DescribePatchBaselinesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchBaselines indicates an expected call of DescribePatchBaselines
+func (mr *MockSSMClientMockRecorder) DescribePatchBaselines(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchBaselines", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchBaselines), arg0)
+}
+
+// DescribePatchBaselinesPages mocks base method
+func (m *MockSSMClient) DescribePatchBaselinesPages(arg0 *ssm.DescribePatchBaselinesInput, arg1 func(*ssm.DescribePatchBaselinesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchBaselinesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePatchBaselinesPages indicates an expected call of DescribePatchBaselinesPages
+func (mr *MockSSMClientMockRecorder) DescribePatchBaselinesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchBaselinesPages", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchBaselinesPages), arg0, arg1)
+}
+
+// DescribePatchBaselinesPagesWithContext mocks base method
+func (m *MockSSMClient) DescribePatchBaselinesPagesWithContext(arg0 context.Context, arg1 *ssm.DescribePatchBaselinesInput, arg2 func(*ssm.DescribePatchBaselinesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePatchBaselinesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePatchBaselinesPagesWithContext indicates an expected call of DescribePatchBaselinesPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribePatchBaselinesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchBaselinesPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchBaselinesPagesWithContext), varargs...)
+}
+
+// DescribePatchBaselinesRequest mocks base method
+func (m *MockSSMClient) DescribePatchBaselinesRequest(arg0 *ssm.DescribePatchBaselinesInput) (*request.Request, *ssm.DescribePatchBaselinesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchBaselinesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribePatchBaselinesOutput)
+	return ret0, ret1
+}
+
+// DescribePatchBaselinesRequest indicates an expected call of DescribePatchBaselinesRequest
+func (mr *MockSSMClientMockRecorder) DescribePatchBaselinesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchBaselinesRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchBaselinesRequest), arg0)
+}
+
+// DescribePatchBaselinesWithContext mocks base method
+func (m *MockSSMClient) DescribePatchBaselinesWithContext(arg0 context.Context, arg1 *ssm.DescribePatchBaselinesInput, arg2 ...request.Option) (*ssm.DescribePatchBaselinesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePatchBaselinesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribePatchBaselinesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchBaselinesWithContext indicates an expected call of DescribePatchBaselinesWithContext
+func (mr *MockSSMClientMockRecorder) DescribePatchBaselinesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchBaselinesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchBaselinesWithContext), varargs...)
+}
+
+// DescribePatchGroupState mocks base method
+func (m *MockSSMClient) DescribePatchGroupState(arg0 *ssm.DescribePatchGroupStateInput) (*ssm.DescribePatchGroupStateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchGroupState", arg0)
+	ret0, _ := ret[0].(*ssm.DescribePatchGroupStateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchGroupState indicates an expected call of DescribePatchGroupState
+func (mr *MockSSMClientMockRecorder) DescribePatchGroupState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroupState", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroupState), arg0)
+}
+
+// DescribePatchGroupStateRequest mocks base method
+func (m *MockSSMClient) DescribePatchGroupStateRequest(arg0 *ssm.DescribePatchGroupStateInput) (*request.Request, *ssm.DescribePatchGroupStateOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchGroupStateRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribePatchGroupStateOutput)
+	return ret0, ret1
+}
+
+// DescribePatchGroupStateRequest indicates an expected call of DescribePatchGroupStateRequest
+func (mr *MockSSMClientMockRecorder) DescribePatchGroupStateRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroupStateRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroupStateRequest), arg0)
+}
+
+// DescribePatchGroupStateWithContext mocks base method
+func (m *MockSSMClient) DescribePatchGroupStateWithContext(arg0 context.Context, arg1 *ssm.DescribePatchGroupStateInput, arg2 ...request.Option) (*ssm.DescribePatchGroupStateOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePatchGroupStateWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribePatchGroupStateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchGroupStateWithContext indicates an expected call of DescribePatchGroupStateWithContext
+func (mr *MockSSMClientMockRecorder) DescribePatchGroupStateWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroupStateWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroupStateWithContext), varargs...)
+}
+
+// DescribePatchGroups mocks base method
+func (m *MockSSMClient) DescribePatchGroups(arg0 *ssm.DescribePatchGroupsInput) (*ssm.DescribePatchGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchGroups", arg0)
+	ret0, _ := ret[0].(*ssm.DescribePatchGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchGroups indicates an expected call of DescribePatchGroups
+func (mr *MockSSMClientMockRecorder) DescribePatchGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroups", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroups), arg0)
+}
+
+// DescribePatchGroupsPages mocks base method
+func (m *MockSSMClient) DescribePatchGroupsPages(arg0 *ssm.DescribePatchGroupsInput, arg1 func(*ssm.DescribePatchGroupsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchGroupsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePatchGroupsPages indicates an expected call of DescribePatchGroupsPages
+func (mr *MockSSMClientMockRecorder) DescribePatchGroupsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroupsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroupsPages), arg0, arg1)
+}
+
+// DescribePatchGroupsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribePatchGroupsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribePatchGroupsInput, arg2 func(*ssm.DescribePatchGroupsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePatchGroupsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePatchGroupsPagesWithContext indicates an expected call of DescribePatchGroupsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribePatchGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroupsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroupsPagesWithContext), varargs...)
+}
+
+// DescribePatchGroupsRequest mocks base method
+func (m *MockSSMClient) DescribePatchGroupsRequest(arg0 *ssm.DescribePatchGroupsInput) (*request.Request, *ssm.DescribePatchGroupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchGroupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribePatchGroupsOutput)
+	return ret0, ret1
+}
+
+// DescribePatchGroupsRequest indicates an expected call of DescribePatchGroupsRequest
+func (mr *MockSSMClientMockRecorder) DescribePatchGroupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroupsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroupsRequest), arg0)
+}
+
+// DescribePatchGroupsWithContext mocks base method
+func (m *MockSSMClient) DescribePatchGroupsWithContext(arg0 context.Context, arg1 *ssm.DescribePatchGroupsInput, arg2 ...request.Option) (*ssm.DescribePatchGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePatchGroupsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribePatchGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchGroupsWithContext indicates an expected call of DescribePatchGroupsWithContext
+func (mr *MockSSMClientMockRecorder) DescribePatchGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchGroupsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchGroupsWithContext), varargs...)
+}
+
+// DescribePatchProperties mocks base method
+func (m *MockSSMClient) DescribePatchProperties(arg0 *ssm.DescribePatchPropertiesInput) (*ssm.DescribePatchPropertiesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchProperties", arg0)
+	ret0, _ := ret[0].(*ssm.DescribePatchPropertiesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchProperties indicates an expected call of DescribePatchProperties
+func (mr *MockSSMClientMockRecorder) DescribePatchProperties(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchProperties", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchProperties), arg0)
+}
+
+// DescribePatchPropertiesPages mocks base method
+func (m *MockSSMClient) DescribePatchPropertiesPages(arg0 *ssm.DescribePatchPropertiesInput, arg1 func(*ssm.DescribePatchPropertiesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchPropertiesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePatchPropertiesPages indicates an expected call of DescribePatchPropertiesPages
+func (mr *MockSSMClientMockRecorder) DescribePatchPropertiesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchPropertiesPages", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchPropertiesPages), arg0, arg1)
+}
+
+// DescribePatchPropertiesPagesWithContext mocks base method
+func (m *MockSSMClient) DescribePatchPropertiesPagesWithContext(arg0 context.Context, arg1 *ssm.DescribePatchPropertiesInput, arg2 func(*ssm.DescribePatchPropertiesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePatchPropertiesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribePatchPropertiesPagesWithContext indicates an expected call of DescribePatchPropertiesPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribePatchPropertiesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchPropertiesPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchPropertiesPagesWithContext), varargs...)
+}
+
+// DescribePatchPropertiesRequest mocks base method
+func (m *MockSSMClient) DescribePatchPropertiesRequest(arg0 *ssm.DescribePatchPropertiesInput) (*request.Request, *ssm.DescribePatchPropertiesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePatchPropertiesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribePatchPropertiesOutput)
+	return ret0, ret1
+}
+
+// DescribePatchPropertiesRequest indicates an expected call of DescribePatchPropertiesRequest
+func (mr *MockSSMClientMockRecorder) DescribePatchPropertiesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchPropertiesRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchPropertiesRequest), arg0)
+}
+
+// DescribePatchPropertiesWithContext mocks base method
+func (m *MockSSMClient) DescribePatchPropertiesWithContext(arg0 context.Context, arg1 *ssm.DescribePatchPropertiesInput, arg2 ...request.Option) (*ssm.DescribePatchPropertiesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePatchPropertiesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribePatchPropertiesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePatchPropertiesWithContext indicates an expected call of DescribePatchPropertiesWithContext
+func (mr *MockSSMClientMockRecorder) DescribePatchPropertiesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePatchPropertiesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribePatchPropertiesWithContext), varargs...)
+}
+
+// DescribeSessions mocks base method
+func (m *MockSSMClient) DescribeSessions(arg0 *ssm.DescribeSessionsInput) (*ssm.DescribeSessionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSessions", arg0)
+	ret0, _ := ret[0].(*ssm.DescribeSessionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSessions indicates an expected call of DescribeSessions
+func (mr *MockSSMClientMockRecorder) DescribeSessions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSessions", reflect.TypeOf((*MockSSMClient)(nil).DescribeSessions), arg0)
+}
+
+// DescribeSessionsPages mocks base method
+func (m *MockSSMClient) DescribeSessionsPages(arg0 *ssm.DescribeSessionsInput, arg1 func(*ssm.DescribeSessionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSessionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeSessionsPages indicates an expected call of DescribeSessionsPages
+func (mr *MockSSMClientMockRecorder) DescribeSessionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSessionsPages", reflect.TypeOf((*MockSSMClient)(nil).DescribeSessionsPages), arg0, arg1)
+}
+
+// DescribeSessionsPagesWithContext mocks base method
+func (m *MockSSMClient) DescribeSessionsPagesWithContext(arg0 context.Context, arg1 *ssm.DescribeSessionsInput, arg2 func(*ssm.DescribeSessionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSessionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeSessionsPagesWithContext indicates an expected call of DescribeSessionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) DescribeSessionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSessionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeSessionsPagesWithContext), varargs...)
+}
+
+// DescribeSessionsRequest mocks base method
+func (m *MockSSMClient) DescribeSessionsRequest(arg0 *ssm.DescribeSessionsInput) (*request.Request, *ssm.DescribeSessionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSessionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.DescribeSessionsOutput)
+	return ret0, ret1
+}
+
+// DescribeSessionsRequest indicates an expected call of DescribeSessionsRequest
+func (mr *MockSSMClientMockRecorder) DescribeSessionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSessionsRequest", reflect.TypeOf((*MockSSMClient)(nil).DescribeSessionsRequest), arg0)
+}
+
+// DescribeSessionsWithContext mocks base method
+func (m *MockSSMClient) DescribeSessionsWithContext(arg0 context.Context, arg1 *ssm.DescribeSessionsInput, arg2 ...request.Option) (*ssm.DescribeSessionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSessionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.DescribeSessionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSessionsWithContext indicates an expected call of DescribeSessionsWithContext
+func (mr *MockSSMClientMockRecorder) DescribeSessionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSessionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).DescribeSessionsWithContext), varargs...)
+}
+
+// GetAutomationExecution mocks base method
+func (m *MockSSMClient) GetAutomationExecution(arg0 *ssm.GetAutomationExecutionInput) (*ssm.GetAutomationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationExecution", arg0)
+	ret0, _ := ret[0].(*ssm.GetAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomationExecution indicates an expected call of GetAutomationExecution
+func (mr *MockSSMClientMockRecorder) GetAutomationExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationExecution", reflect.TypeOf((*MockSSMClient)(nil).GetAutomationExecution), arg0)
+}
+
+// GetAutomationExecutionRequest mocks base method
+func (m *MockSSMClient) GetAutomationExecutionRequest(arg0 *ssm.GetAutomationExecutionInput) (*request.Request, *ssm.GetAutomationExecutionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationExecutionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetAutomationExecutionOutput)
+	return ret0, ret1
+}
+
+// GetAutomationExecutionRequest indicates an expected call of GetAutomationExecutionRequest
+func (mr *MockSSMClientMockRecorder) GetAutomationExecutionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationExecutionRequest", reflect.TypeOf((*MockSSMClient)(nil).GetAutomationExecutionRequest), arg0)
+}
+
+// GetAutomationExecutionWithContext mocks base method
+func (m *MockSSMClient) GetAutomationExecutionWithContext(arg0 context.Context, arg1 *ssm.GetAutomationExecutionInput, arg2 ...request.Option) (*ssm.GetAutomationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutomationExecutionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomationExecutionWithContext indicates an expected call of GetAutomationExecutionWithContext
+func (mr *MockSSMClientMockRecorder) GetAutomationExecutionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationExecutionWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetAutomationExecutionWithContext), varargs...)
+}
+
+// GetCalendarState mocks base method
+func (m *MockSSMClient) GetCalendarState(arg0 *ssm.GetCalendarStateInput) (*ssm.GetCalendarStateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarState", arg0)
+	ret0, _ := ret[0].(*ssm.GetCalendarStateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarState indicates an expected call of GetCalendarState
+func (mr *MockSSMClientMockRecorder) GetCalendarState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarState", reflect.TypeOf((*MockSSMClient)(nil).GetCalendarState), arg0)
+}
+
+// GetCalendarStateRequest mocks base method
+func (m *MockSSMClient) GetCalendarStateRequest(arg0 *ssm.GetCalendarStateInput) (*request.Request, *ssm.GetCalendarStateOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarStateRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetCalendarStateOutput)
+	return ret0, ret1
+}
+
+// GetCalendarStateRequest indicates an expected call of GetCalendarStateRequest
+func (mr *MockSSMClientMockRecorder) GetCalendarStateRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarStateRequest", reflect.TypeOf((*MockSSMClient)(nil).GetCalendarStateRequest), arg0)
+}
+
+// GetCalendarStateWithContext mocks base method
+func (m *MockSSMClient) GetCalendarStateWithContext(arg0 context.Context, arg1 *ssm.GetCalendarStateInput, arg2 ...request.Option) (*ssm.GetCalendarStateOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCalendarStateWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetCalendarStateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarStateWithContext indicates an expected call of GetCalendarStateWithContext
+func (mr *MockSSMClientMockRecorder) GetCalendarStateWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarStateWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetCalendarStateWithContext), varargs...)
+}
+
+// GetCommandInvocation mocks base method
+func (m *MockSSMClient) GetCommandInvocation(arg0 *ssm.GetCommandInvocationInput) (*ssm.GetCommandInvocationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommandInvocation", arg0)
+	ret0, _ := ret[0].(*ssm.GetCommandInvocationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommandInvocation indicates an expected call of GetCommandInvocation
+func (mr *MockSSMClientMockRecorder) GetCommandInvocation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommandInvocation", reflect.TypeOf((*MockSSMClient)(nil).GetCommandInvocation), arg0)
+}
+
+// GetCommandInvocationRequest mocks base method
+func (m *MockSSMClient) GetCommandInvocationRequest(arg0 *ssm.GetCommandInvocationInput) (*request.Request, *ssm.GetCommandInvocationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommandInvocationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetCommandInvocationOutput)
+	return ret0, ret1
+}
+
+// GetCommandInvocationRequest indicates an expected call of GetCommandInvocationRequest
+func (mr *MockSSMClientMockRecorder) GetCommandInvocationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommandInvocationRequest", reflect.TypeOf((*MockSSMClient)(nil).GetCommandInvocationRequest), arg0)
+}
+
+// GetCommandInvocationWithContext mocks base method
+func (m *MockSSMClient) GetCommandInvocationWithContext(arg0 context.Context, arg1 *ssm.GetCommandInvocationInput, arg2 ...request.Option) (*ssm.GetCommandInvocationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCommandInvocationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetCommandInvocationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommandInvocationWithContext indicates an expected call of GetCommandInvocationWithContext
+func (mr *MockSSMClientMockRecorder) GetCommandInvocationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommandInvocationWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetCommandInvocationWithContext), varargs...)
+}
+
+// GetConnectionStatus mocks base method
+func (m *MockSSMClient) GetConnectionStatus(arg0 *ssm.GetConnectionStatusInput) (*ssm.GetConnectionStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectionStatus", arg0)
+	ret0, _ := ret[0].(*ssm.GetConnectionStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectionStatus indicates an expected call of GetConnectionStatus
+func (mr *MockSSMClientMockRecorder) GetConnectionStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionStatus", reflect.TypeOf((*MockSSMClient)(nil).GetConnectionStatus), arg0)
+}
+
+// GetConnectionStatusRequest mocks base method
+func (m *MockSSMClient) GetConnectionStatusRequest(arg0 *ssm.GetConnectionStatusInput) (*request.Request, *ssm.GetConnectionStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectionStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetConnectionStatusOutput)
+	return ret0, ret1
+}
+
+// GetConnectionStatusRequest indicates an expected call of GetConnectionStatusRequest
+func (mr *MockSSMClientMockRecorder) GetConnectionStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionStatusRequest", reflect.TypeOf((*MockSSMClient)(nil).GetConnectionStatusRequest), arg0)
+}
+
+// GetConnectionStatusWithContext mocks base method
+func (m *MockSSMClient) GetConnectionStatusWithContext(arg0 context.Context, arg1 *ssm.GetConnectionStatusInput, arg2 ...request.Option) (*ssm.GetConnectionStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConnectionStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetConnectionStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectionStatusWithContext indicates an expected call of GetConnectionStatusWithContext
+func (mr *MockSSMClientMockRecorder) GetConnectionStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionStatusWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetConnectionStatusWithContext), varargs...)
+}
+
+// GetDefaultPatchBaseline mocks base method
+func (m *MockSSMClient) GetDefaultPatchBaseline(arg0 *ssm.GetDefaultPatchBaselineInput) (*ssm.GetDefaultPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultPatchBaseline", arg0)
+	ret0, _ := ret[0].(*ssm.GetDefaultPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultPatchBaseline indicates an expected call of GetDefaultPatchBaseline
+func (mr *MockSSMClientMockRecorder) GetDefaultPatchBaseline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultPatchBaseline", reflect.TypeOf((*MockSSMClient)(nil).GetDefaultPatchBaseline), arg0)
+}
+
+// GetDefaultPatchBaselineRequest mocks base method
+func (m *MockSSMClient) GetDefaultPatchBaselineRequest(arg0 *ssm.GetDefaultPatchBaselineInput) (*request.Request, *ssm.GetDefaultPatchBaselineOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultPatchBaselineRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetDefaultPatchBaselineOutput)
+	return ret0, ret1
+}
+
+// GetDefaultPatchBaselineRequest indicates an expected call of GetDefaultPatchBaselineRequest
+func (mr *MockSSMClientMockRecorder) GetDefaultPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultPatchBaselineRequest", reflect.TypeOf((*MockSSMClient)(nil).GetDefaultPatchBaselineRequest), arg0)
+}
+
+// GetDefaultPatchBaselineWithContext mocks base method
+func (m *MockSSMClient) GetDefaultPatchBaselineWithContext(arg0 context.Context, arg1 *ssm.GetDefaultPatchBaselineInput, arg2 ...request.Option) (*ssm.GetDefaultPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDefaultPatchBaselineWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetDefaultPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultPatchBaselineWithContext indicates an expected call of GetDefaultPatchBaselineWithContext
+func (mr *MockSSMClientMockRecorder) GetDefaultPatchBaselineWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultPatchBaselineWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetDefaultPatchBaselineWithContext), varargs...)
+}
+
+// GetDeployablePatchSnapshotForInstance mocks base method
+func (m *MockSSMClient) GetDeployablePatchSnapshotForInstance(arg0 *ssm.GetDeployablePatchSnapshotForInstanceInput) (*ssm.GetDeployablePatchSnapshotForInstanceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployablePatchSnapshotForInstance", arg0)
+	ret0, _ := ret[0].(*ssm.GetDeployablePatchSnapshotForInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeployablePatchSnapshotForInstance indicates an expected call of GetDeployablePatchSnapshotForInstance
+func (mr *MockSSMClientMockRecorder) GetDeployablePatchSnapshotForInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployablePatchSnapshotForInstance", reflect.TypeOf((*MockSSMClient)(nil).GetDeployablePatchSnapshotForInstance), arg0)
+}
+
+// GetDeployablePatchSnapshotForInstanceRequest mocks base method
+func (m *MockSSMClient) GetDeployablePatchSnapshotForInstanceRequest(arg0 *ssm.GetDeployablePatchSnapshotForInstanceInput) (*request.Request, *ssm.GetDeployablePatchSnapshotForInstanceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployablePatchSnapshotForInstanceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetDeployablePatchSnapshotForInstanceOutput)
+	return ret0, ret1
+}
+
+// GetDeployablePatchSnapshotForInstanceRequest indicates an expected call of GetDeployablePatchSnapshotForInstanceRequest
+func (mr *MockSSMClientMockRecorder) GetDeployablePatchSnapshotForInstanceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployablePatchSnapshotForInstanceRequest", reflect.TypeOf((*MockSSMClient)(nil).GetDeployablePatchSnapshotForInstanceRequest), arg0)
+}
+
+// GetDeployablePatchSnapshotForInstanceWithContext mocks base method
+func (m *MockSSMClient) GetDeployablePatchSnapshotForInstanceWithContext(arg0 context.Context, arg1 *ssm.GetDeployablePatchSnapshotForInstanceInput, arg2 ...request.Option) (*ssm.GetDeployablePatchSnapshotForInstanceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeployablePatchSnapshotForInstanceWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetDeployablePatchSnapshotForInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeployablePatchSnapshotForInstanceWithContext indicates an expected call of GetDeployablePatchSnapshotForInstanceWithContext
+func (mr *MockSSMClientMockRecorder) GetDeployablePatchSnapshotForInstanceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployablePatchSnapshotForInstanceWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetDeployablePatchSnapshotForInstanceWithContext), varargs...)
+}
+
+// GetDocument mocks base method
+func (m *MockSSMClient) GetDocument(arg0 *ssm.GetDocumentInput) (*ssm.GetDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocument", arg0)
+	ret0, _ := ret[0].(*ssm.GetDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocument indicates an expected call of GetDocument
+func (mr *MockSSMClientMockRecorder) GetDocument(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocument", reflect.TypeOf((*MockSSMClient)(nil).GetDocument), arg0)
+}
+
+// GetDocumentRequest mocks base method
+func (m *MockSSMClient) GetDocumentRequest(arg0 *ssm.GetDocumentInput) (*request.Request, *ssm.GetDocumentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocumentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetDocumentOutput)
+	return ret0, ret1
+}
+
+// GetDocumentRequest indicates an expected call of GetDocumentRequest
+func (mr *MockSSMClientMockRecorder) GetDocumentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentRequest", reflect.TypeOf((*MockSSMClient)(nil).GetDocumentRequest), arg0)
+}
+
+// GetDocumentWithContext mocks base method
+func (m *MockSSMClient) GetDocumentWithContext(arg0 context.Context, arg1 *ssm.GetDocumentInput, arg2 ...request.Option) (*ssm.GetDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDocumentWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocumentWithContext indicates an expected call of GetDocumentWithContext
+func (mr *MockSSMClientMockRecorder) GetDocumentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetDocumentWithContext), varargs...)
+}
+
+// GetInventory mocks base method
+func (m *MockSSMClient) GetInventory(arg0 *ssm.GetInventoryInput) (*ssm.GetInventoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventory", arg0)
+	ret0, _ := ret[0].(*ssm.GetInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventory indicates an expected call of GetInventory
+func (mr *MockSSMClientMockRecorder) GetInventory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventory", reflect.TypeOf((*MockSSMClient)(nil).GetInventory), arg0)
+}
+
+// GetInventoryPages mocks base method
+func (m *MockSSMClient) GetInventoryPages(arg0 *ssm.GetInventoryInput, arg1 func(*ssm.GetInventoryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventoryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetInventoryPages indicates an expected call of GetInventoryPages
+func (mr *MockSSMClientMockRecorder) GetInventoryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryPages", reflect.TypeOf((*MockSSMClient)(nil).GetInventoryPages), arg0, arg1)
+}
+
+// GetInventoryPagesWithContext mocks base method
+func (m *MockSSMClient) GetInventoryPagesWithContext(arg0 context.Context, arg1 *ssm.GetInventoryInput, arg2 func(*ssm.GetInventoryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInventoryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetInventoryPagesWithContext indicates an expected call of GetInventoryPagesWithContext
+func (mr *MockSSMClientMockRecorder) GetInventoryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetInventoryPagesWithContext), varargs...)
+}
+
+// GetInventoryRequest mocks base method
+func (m *MockSSMClient) GetInventoryRequest(arg0 *ssm.GetInventoryInput) (*request.Request, *ssm.GetInventoryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventoryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetInventoryOutput)
+	return ret0, ret1
+}
+
+// GetInventoryRequest indicates an expected call of GetInventoryRequest
+func (mr *MockSSMClientMockRecorder) GetInventoryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryRequest", reflect.TypeOf((*MockSSMClient)(nil).GetInventoryRequest), arg0)
+}
+
+// GetInventorySchema mocks base method
+func (m *MockSSMClient) GetInventorySchema(arg0 *ssm.GetInventorySchemaInput) (*ssm.GetInventorySchemaOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventorySchema", arg0)
+	ret0, _ := ret[0].(*ssm.GetInventorySchemaOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventorySchema indicates an expected call of GetInventorySchema
+func (mr *MockSSMClientMockRecorder) GetInventorySchema(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventorySchema", reflect.TypeOf((*MockSSMClient)(nil).GetInventorySchema), arg0)
+}
+
+// GetInventorySchemaPages mocks base method
+func (m *MockSSMClient) GetInventorySchemaPages(arg0 *ssm.GetInventorySchemaInput, arg1 func(*ssm.GetInventorySchemaOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventorySchemaPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetInventorySchemaPages indicates an expected call of GetInventorySchemaPages
+func (mr *MockSSMClientMockRecorder) GetInventorySchemaPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventorySchemaPages", reflect.TypeOf((*MockSSMClient)(nil).GetInventorySchemaPages), arg0, arg1)
+}
+
+// GetInventorySchemaPagesWithContext mocks base method
+func (m *MockSSMClient) GetInventorySchemaPagesWithContext(arg0 context.Context, arg1 *ssm.GetInventorySchemaInput, arg2 func(*ssm.GetInventorySchemaOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInventorySchemaPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetInventorySchemaPagesWithContext indicates an expected call of GetInventorySchemaPagesWithContext
+func (mr *MockSSMClientMockRecorder) GetInventorySchemaPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventorySchemaPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetInventorySchemaPagesWithContext), varargs...)
+}
+
+// GetInventorySchemaRequest mocks base method
+func (m *MockSSMClient) GetInventorySchemaRequest(arg0 *ssm.GetInventorySchemaInput) (*request.Request, *ssm.GetInventorySchemaOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventorySchemaRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetInventorySchemaOutput)
+	return ret0, ret1
+}
+
+// GetInventorySchemaRequest indicates an expected call of GetInventorySchemaRequest
+func (mr *MockSSMClientMockRecorder) GetInventorySchemaRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventorySchemaRequest", reflect.TypeOf((*MockSSMClient)(nil).GetInventorySchemaRequest), arg0)
+}
+
+// GetInventorySchemaWithContext mocks base method
+func (m *MockSSMClient) GetInventorySchemaWithContext(arg0 context.Context, arg1 *ssm.GetInventorySchemaInput, arg2 ...request.Option) (*ssm.GetInventorySchemaOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInventorySchemaWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetInventorySchemaOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventorySchemaWithContext indicates an expected call of GetInventorySchemaWithContext
+func (mr *MockSSMClientMockRecorder) GetInventorySchemaWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventorySchemaWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetInventorySchemaWithContext), varargs...)
+}
+
+// GetInventoryWithContext mocks base method
+func (m *MockSSMClient) GetInventoryWithContext(arg0 context.Context, arg1 *ssm.GetInventoryInput, arg2 ...request.Option) (*ssm.GetInventoryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInventoryWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventoryWithContext indicates an expected call of GetInventoryWithContext
+func (mr *MockSSMClientMockRecorder) GetInventoryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetInventoryWithContext), varargs...)
+}
+
+// GetMaintenanceWindow mocks base method
+func (m *MockSSMClient) GetMaintenanceWindow(arg0 *ssm.GetMaintenanceWindowInput) (*ssm.GetMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindow indicates an expected call of GetMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindow), arg0)
+}
+
+// GetMaintenanceWindowExecution mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecution(arg0 *ssm.GetMaintenanceWindowExecutionInput) (*ssm.GetMaintenanceWindowExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecution", arg0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecution indicates an expected call of GetMaintenanceWindowExecution
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecution", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecution), arg0)
+}
+
+// GetMaintenanceWindowExecutionRequest mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionRequest(arg0 *ssm.GetMaintenanceWindowExecutionInput) (*request.Request, *ssm.GetMaintenanceWindowExecutionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowExecutionOutput)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionRequest indicates an expected call of GetMaintenanceWindowExecutionRequest
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionRequest", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionRequest), arg0)
+}
+
+// GetMaintenanceWindowExecutionTask mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionTask(arg0 *ssm.GetMaintenanceWindowExecutionTaskInput) (*ssm.GetMaintenanceWindowExecutionTaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionTask", arg0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionTask indicates an expected call of GetMaintenanceWindowExecutionTask
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionTask(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionTask", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionTask), arg0)
+}
+
+// GetMaintenanceWindowExecutionTaskInvocation mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionTaskInvocation(arg0 *ssm.GetMaintenanceWindowExecutionTaskInvocationInput) (*ssm.GetMaintenanceWindowExecutionTaskInvocationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionTaskInvocation", arg0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionTaskInvocationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionTaskInvocation indicates an expected call of GetMaintenanceWindowExecutionTaskInvocation
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionTaskInvocation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionTaskInvocation", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionTaskInvocation), arg0)
+}
+
+// GetMaintenanceWindowExecutionTaskInvocationRequest mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionTaskInvocationRequest(arg0 *ssm.GetMaintenanceWindowExecutionTaskInvocationInput) (*request.Request, *ssm.GetMaintenanceWindowExecutionTaskInvocationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionTaskInvocationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowExecutionTaskInvocationOutput)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionTaskInvocationRequest indicates an expected call of GetMaintenanceWindowExecutionTaskInvocationRequest
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionTaskInvocationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionTaskInvocationRequest", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionTaskInvocationRequest), arg0)
+}
+
+// GetMaintenanceWindowExecutionTaskInvocationWithContext mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionTaskInvocationWithContext(arg0 context.Context, arg1 *ssm.GetMaintenanceWindowExecutionTaskInvocationInput, arg2 ...request.Option) (*ssm.GetMaintenanceWindowExecutionTaskInvocationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionTaskInvocationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionTaskInvocationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionTaskInvocationWithContext indicates an expected call of GetMaintenanceWindowExecutionTaskInvocationWithContext
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionTaskInvocationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionTaskInvocationWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionTaskInvocationWithContext), varargs...)
+}
+
+// GetMaintenanceWindowExecutionTaskRequest mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionTaskRequest(arg0 *ssm.GetMaintenanceWindowExecutionTaskInput) (*request.Request, *ssm.GetMaintenanceWindowExecutionTaskOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionTaskRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowExecutionTaskOutput)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionTaskRequest indicates an expected call of GetMaintenanceWindowExecutionTaskRequest
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionTaskRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionTaskRequest", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionTaskRequest), arg0)
+}
+
+// GetMaintenanceWindowExecutionTaskWithContext mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionTaskWithContext(arg0 context.Context, arg1 *ssm.GetMaintenanceWindowExecutionTaskInput, arg2 ...request.Option) (*ssm.GetMaintenanceWindowExecutionTaskOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionTaskWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionTaskWithContext indicates an expected call of GetMaintenanceWindowExecutionTaskWithContext
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionTaskWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionTaskWithContext), varargs...)
+}
+
+// GetMaintenanceWindowExecutionWithContext mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowExecutionWithContext(arg0 context.Context, arg1 *ssm.GetMaintenanceWindowExecutionInput, arg2 ...request.Option) (*ssm.GetMaintenanceWindowExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowExecutionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowExecutionWithContext indicates an expected call of GetMaintenanceWindowExecutionWithContext
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowExecutionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowExecutionWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowExecutionWithContext), varargs...)
+}
+
+// GetMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowRequest(arg0 *ssm.GetMaintenanceWindowInput) (*request.Request, *ssm.GetMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowRequest indicates an expected call of GetMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowRequest), arg0)
+}
+
+// GetMaintenanceWindowTask mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowTask(arg0 *ssm.GetMaintenanceWindowTaskInput) (*ssm.GetMaintenanceWindowTaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowTask", arg0)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowTask indicates an expected call of GetMaintenanceWindowTask
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowTask(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowTask", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowTask), arg0)
+}
+
+// GetMaintenanceWindowTaskRequest mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowTaskRequest(arg0 *ssm.GetMaintenanceWindowTaskInput) (*request.Request, *ssm.GetMaintenanceWindowTaskOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowTaskRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetMaintenanceWindowTaskOutput)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowTaskRequest indicates an expected call of GetMaintenanceWindowTaskRequest
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowTaskRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowTaskRequest", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowTaskRequest), arg0)
+}
+
+// GetMaintenanceWindowTaskWithContext mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowTaskWithContext(arg0 context.Context, arg1 *ssm.GetMaintenanceWindowTaskInput, arg2 ...request.Option) (*ssm.GetMaintenanceWindowTaskOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowTaskWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowTaskWithContext indicates an expected call of GetMaintenanceWindowTaskWithContext
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowTaskWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowTaskWithContext), varargs...)
+}
+
+// GetMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) GetMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.GetMaintenanceWindowInput, arg2 ...request.Option) (*ssm.GetMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindowWithContext indicates an expected call of GetMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) GetMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetMaintenanceWindowWithContext), varargs...)
+}
+
+// GetOpsItem mocks base method
+func (m *MockSSMClient) GetOpsItem(arg0 *ssm.GetOpsItemInput) (*ssm.GetOpsItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpsItem", arg0)
+	ret0, _ := ret[0].(*ssm.GetOpsItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpsItem indicates an expected call of GetOpsItem
+func (mr *MockSSMClientMockRecorder) GetOpsItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsItem", reflect.TypeOf((*MockSSMClient)(nil).GetOpsItem), arg0)
+}
+
+// GetOpsItemRequest mocks base method
+func (m *MockSSMClient) GetOpsItemRequest(arg0 *ssm.GetOpsItemInput) (*request.Request, *ssm.GetOpsItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpsItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetOpsItemOutput)
+	return ret0, ret1
+}
+
+// GetOpsItemRequest indicates an expected call of GetOpsItemRequest
+func (mr *MockSSMClientMockRecorder) GetOpsItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsItemRequest", reflect.TypeOf((*MockSSMClient)(nil).GetOpsItemRequest), arg0)
+}
+
+// GetOpsItemWithContext mocks base method
+func (m *MockSSMClient) GetOpsItemWithContext(arg0 context.Context, arg1 *ssm.GetOpsItemInput, arg2 ...request.Option) (*ssm.GetOpsItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOpsItemWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetOpsItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpsItemWithContext indicates an expected call of GetOpsItemWithContext
+func (mr *MockSSMClientMockRecorder) GetOpsItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsItemWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetOpsItemWithContext), varargs...)
+}
+
+// GetOpsSummary mocks base method
+func (m *MockSSMClient) GetOpsSummary(arg0 *ssm.GetOpsSummaryInput) (*ssm.GetOpsSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpsSummary", arg0)
+	ret0, _ := ret[0].(*ssm.GetOpsSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpsSummary indicates an expected call of GetOpsSummary
+func (mr *MockSSMClientMockRecorder) GetOpsSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsSummary", reflect.TypeOf((*MockSSMClient)(nil).GetOpsSummary), arg0)
+}
+
+// GetOpsSummaryPages mocks base method
+func (m *MockSSMClient) GetOpsSummaryPages(arg0 *ssm.GetOpsSummaryInput, arg1 func(*ssm.GetOpsSummaryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpsSummaryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOpsSummaryPages indicates an expected call of GetOpsSummaryPages
+func (mr *MockSSMClientMockRecorder) GetOpsSummaryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsSummaryPages", reflect.TypeOf((*MockSSMClient)(nil).GetOpsSummaryPages), arg0, arg1)
+}
+
+// GetOpsSummaryPagesWithContext mocks base method
+func (m *MockSSMClient) GetOpsSummaryPagesWithContext(arg0 context.Context, arg1 *ssm.GetOpsSummaryInput, arg2 func(*ssm.GetOpsSummaryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOpsSummaryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetOpsSummaryPagesWithContext indicates an expected call of GetOpsSummaryPagesWithContext
+func (mr *MockSSMClientMockRecorder) GetOpsSummaryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsSummaryPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetOpsSummaryPagesWithContext), varargs...)
+}
+
+// GetOpsSummaryRequest mocks base method
+func (m *MockSSMClient) GetOpsSummaryRequest(arg0 *ssm.GetOpsSummaryInput) (*request.Request, *ssm.GetOpsSummaryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpsSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetOpsSummaryOutput)
+	return ret0, ret1
+}
+
+// GetOpsSummaryRequest indicates an expected call of GetOpsSummaryRequest
+func (mr *MockSSMClientMockRecorder) GetOpsSummaryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsSummaryRequest", reflect.TypeOf((*MockSSMClient)(nil).GetOpsSummaryRequest), arg0)
+}
+
+// GetOpsSummaryWithContext mocks base method
+func (m *MockSSMClient) GetOpsSummaryWithContext(arg0 context.Context, arg1 *ssm.GetOpsSummaryInput, arg2 ...request.Option) (*ssm.GetOpsSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOpsSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetOpsSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpsSummaryWithContext indicates an expected call of GetOpsSummaryWithContext
+func (mr *MockSSMClientMockRecorder) GetOpsSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpsSummaryWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetOpsSummaryWithContext), varargs...)
+}
+
+// GetParameter mocks base method
+func (m *MockSSMClient) GetParameter(arg0 *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameter", arg0)
+	ret0, _ := ret[0].(*ssm.GetParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameter indicates an expected call of GetParameter
+func (mr *MockSSMClientMockRecorder) GetParameter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameter", reflect.TypeOf((*MockSSMClient)(nil).GetParameter), arg0)
+}
+
+// GetParameterHistory mocks base method
+func (m *MockSSMClient) GetParameterHistory(arg0 *ssm.GetParameterHistoryInput) (*ssm.GetParameterHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameterHistory", arg0)
+	ret0, _ := ret[0].(*ssm.GetParameterHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameterHistory indicates an expected call of GetParameterHistory
+func (mr *MockSSMClientMockRecorder) GetParameterHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterHistory", reflect.TypeOf((*MockSSMClient)(nil).GetParameterHistory), arg0)
+}
+
+// GetParameterHistoryPages mocks base method
+func (m *MockSSMClient) GetParameterHistoryPages(arg0 *ssm.GetParameterHistoryInput, arg1 func(*ssm.GetParameterHistoryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameterHistoryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetParameterHistoryPages indicates an expected call of GetParameterHistoryPages
+func (mr *MockSSMClientMockRecorder) GetParameterHistoryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterHistoryPages", reflect.TypeOf((*MockSSMClient)(nil).GetParameterHistoryPages), arg0, arg1)
+}
+
+// GetParameterHistoryPagesWithContext mocks base method
+func (m *MockSSMClient) GetParameterHistoryPagesWithContext(arg0 context.Context, arg1 *ssm.GetParameterHistoryInput, arg2 func(*ssm.GetParameterHistoryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetParameterHistoryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetParameterHistoryPagesWithContext indicates an expected call of GetParameterHistoryPagesWithContext
+func (mr *MockSSMClientMockRecorder) GetParameterHistoryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterHistoryPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetParameterHistoryPagesWithContext), varargs...)
+}
+
+// GetParameterHistoryRequest mocks base method
+func (m *MockSSMClient) GetParameterHistoryRequest(arg0 *ssm.GetParameterHistoryInput) (*request.Request, *ssm.GetParameterHistoryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameterHistoryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetParameterHistoryOutput)
+	return ret0, ret1
+}
+
+// GetParameterHistoryRequest indicates an expected call of GetParameterHistoryRequest
+func (mr *MockSSMClientMockRecorder) GetParameterHistoryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterHistoryRequest", reflect.TypeOf((*MockSSMClient)(nil).GetParameterHistoryRequest), arg0)
+}
+
+// GetParameterHistoryWithContext mocks base method
+func (m *MockSSMClient) GetParameterHistoryWithContext(arg0 context.Context, arg1 *ssm.GetParameterHistoryInput, arg2 ...request.Option) (*ssm.GetParameterHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetParameterHistoryWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetParameterHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameterHistoryWithContext indicates an expected call of GetParameterHistoryWithContext
+func (mr *MockSSMClientMockRecorder) GetParameterHistoryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterHistoryWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetParameterHistoryWithContext), varargs...)
+}
+
+// GetParameterRequest mocks base method
+func (m *MockSSMClient) GetParameterRequest(arg0 *ssm.GetParameterInput) (*request.Request, *ssm.GetParameterOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameterRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetParameterOutput)
+	return ret0, ret1
+}
+
+// GetParameterRequest indicates an expected call of GetParameterRequest
+func (mr *MockSSMClientMockRecorder) GetParameterRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterRequest", reflect.TypeOf((*MockSSMClient)(nil).GetParameterRequest), arg0)
+}
+
+// GetParameterWithContext mocks base method
+func (m *MockSSMClient) GetParameterWithContext(arg0 context.Context, arg1 *ssm.GetParameterInput, arg2 ...request.Option) (*ssm.GetParameterOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetParameterWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameterWithContext indicates an expected call of GetParameterWithContext
+func (mr *MockSSMClientMockRecorder) GetParameterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameterWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetParameterWithContext), varargs...)
+}
+
+// GetParameters mocks base method
+func (m *MockSSMClient) GetParameters(arg0 *ssm.GetParametersInput) (*ssm.GetParametersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParameters", arg0)
+	ret0, _ := ret[0].(*ssm.GetParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParameters indicates an expected call of GetParameters
+func (mr *MockSSMClientMockRecorder) GetParameters(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParameters", reflect.TypeOf((*MockSSMClient)(nil).GetParameters), arg0)
+}
+
+// GetParametersByPath mocks base method
+func (m *MockSSMClient) GetParametersByPath(arg0 *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParametersByPath", arg0)
+	ret0, _ := ret[0].(*ssm.GetParametersByPathOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParametersByPath indicates an expected call of GetParametersByPath
+func (mr *MockSSMClientMockRecorder) GetParametersByPath(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersByPath", reflect.TypeOf((*MockSSMClient)(nil).GetParametersByPath), arg0)
+}
+
+// GetParametersByPathPages mocks base method
+func (m *MockSSMClient) GetParametersByPathPages(arg0 *ssm.GetParametersByPathInput, arg1 func(*ssm.GetParametersByPathOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParametersByPathPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetParametersByPathPages indicates an expected call of GetParametersByPathPages
+func (mr *MockSSMClientMockRecorder) GetParametersByPathPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersByPathPages", reflect.TypeOf((*MockSSMClient)(nil).GetParametersByPathPages), arg0, arg1)
+}
+
+// GetParametersByPathPagesWithContext mocks base method
+func (m *MockSSMClient) GetParametersByPathPagesWithContext(arg0 context.Context, arg1 *ssm.GetParametersByPathInput, arg2 func(*ssm.GetParametersByPathOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetParametersByPathPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetParametersByPathPagesWithContext indicates an expected call of GetParametersByPathPagesWithContext
+func (mr *MockSSMClientMockRecorder) GetParametersByPathPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersByPathPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetParametersByPathPagesWithContext), varargs...)
+}
+
+// GetParametersByPathRequest mocks base method
+func (m *MockSSMClient) GetParametersByPathRequest(arg0 *ssm.GetParametersByPathInput) (*request.Request, *ssm.GetParametersByPathOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParametersByPathRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetParametersByPathOutput)
+	return ret0, ret1
+}
+
+// GetParametersByPathRequest indicates an expected call of GetParametersByPathRequest
+func (mr *MockSSMClientMockRecorder) GetParametersByPathRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersByPathRequest", reflect.TypeOf((*MockSSMClient)(nil).GetParametersByPathRequest), arg0)
+}
+
+// GetParametersByPathWithContext mocks base method
+func (m *MockSSMClient) GetParametersByPathWithContext(arg0 context.Context, arg1 *ssm.GetParametersByPathInput, arg2 ...request.Option) (*ssm.GetParametersByPathOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetParametersByPathWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetParametersByPathOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParametersByPathWithContext indicates an expected call of GetParametersByPathWithContext
+func (mr *MockSSMClientMockRecorder) GetParametersByPathWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersByPathWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetParametersByPathWithContext), varargs...)
+}
+
+// GetParametersRequest mocks base method
+func (m *MockSSMClient) GetParametersRequest(arg0 *ssm.GetParametersInput) (*request.Request, *ssm.GetParametersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParametersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetParametersOutput)
+	return ret0, ret1
+}
+
+// GetParametersRequest indicates an expected call of GetParametersRequest
+func (mr *MockSSMClientMockRecorder) GetParametersRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersRequest", reflect.TypeOf((*MockSSMClient)(nil).GetParametersRequest), arg0)
+}
+
+// GetParametersWithContext mocks base method
+func (m *MockSSMClient) GetParametersWithContext(arg0 context.Context, arg1 *ssm.GetParametersInput, arg2 ...request.Option) (*ssm.GetParametersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetParametersWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetParametersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParametersWithContext indicates an expected call of GetParametersWithContext
+func (mr *MockSSMClientMockRecorder) GetParametersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParametersWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetParametersWithContext), varargs...)
+}
+
+// GetPatchBaseline mocks base method
+func (m *MockSSMClient) GetPatchBaseline(arg0 *ssm.GetPatchBaselineInput) (*ssm.GetPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatchBaseline", arg0)
+	ret0, _ := ret[0].(*ssm.GetPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatchBaseline indicates an expected call of GetPatchBaseline
+func (mr *MockSSMClientMockRecorder) GetPatchBaseline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatchBaseline", reflect.TypeOf((*MockSSMClient)(nil).GetPatchBaseline), arg0)
+}
+
+// GetPatchBaselineForPatchGroup mocks base method
+func (m *MockSSMClient) GetPatchBaselineForPatchGroup(arg0 *ssm.GetPatchBaselineForPatchGroupInput) (*ssm.GetPatchBaselineForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatchBaselineForPatchGroup", arg0)
+	ret0, _ := ret[0].(*ssm.GetPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatchBaselineForPatchGroup indicates an expected call of GetPatchBaselineForPatchGroup
+func (mr *MockSSMClientMockRecorder) GetPatchBaselineForPatchGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatchBaselineForPatchGroup", reflect.TypeOf((*MockSSMClient)(nil).GetPatchBaselineForPatchGroup), arg0)
+}
+
+// GetPatchBaselineForPatchGroupRequest mocks base method
+func (m *MockSSMClient) GetPatchBaselineForPatchGroupRequest(arg0 *ssm.GetPatchBaselineForPatchGroupInput) (*request.Request, *ssm.GetPatchBaselineForPatchGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatchBaselineForPatchGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetPatchBaselineForPatchGroupOutput)
+	return ret0, ret1
+}
+
+// GetPatchBaselineForPatchGroupRequest indicates an expected call of GetPatchBaselineForPatchGroupRequest
+func (mr *MockSSMClientMockRecorder) GetPatchBaselineForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatchBaselineForPatchGroupRequest", reflect.TypeOf((*MockSSMClient)(nil).GetPatchBaselineForPatchGroupRequest), arg0)
+}
+
+// GetPatchBaselineForPatchGroupWithContext mocks base method
+func (m *MockSSMClient) GetPatchBaselineForPatchGroupWithContext(arg0 context.Context, arg1 *ssm.GetPatchBaselineForPatchGroupInput, arg2 ...request.Option) (*ssm.GetPatchBaselineForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPatchBaselineForPatchGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatchBaselineForPatchGroupWithContext indicates an expected call of GetPatchBaselineForPatchGroupWithContext
+func (mr *MockSSMClientMockRecorder) GetPatchBaselineForPatchGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatchBaselineForPatchGroupWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetPatchBaselineForPatchGroupWithContext), varargs...)
+}
+
+// GetPatchBaselineRequest mocks base method
+func (m *MockSSMClient) GetPatchBaselineRequest(arg0 *ssm.GetPatchBaselineInput) (*request.Request, *ssm.GetPatchBaselineOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatchBaselineRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetPatchBaselineOutput)
+	return ret0, ret1
+}
+
+// GetPatchBaselineRequest indicates an expected call of GetPatchBaselineRequest
+func (mr *MockSSMClientMockRecorder) GetPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatchBaselineRequest", reflect.TypeOf((*MockSSMClient)(nil).GetPatchBaselineRequest), arg0)
+}
+
+// GetPatchBaselineWithContext mocks base method
+func (m *MockSSMClient) GetPatchBaselineWithContext(arg0 context.Context, arg1 *ssm.GetPatchBaselineInput, arg2 ...request.Option) (*ssm.GetPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPatchBaselineWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatchBaselineWithContext indicates an expected call of GetPatchBaselineWithContext
+func (mr *MockSSMClientMockRecorder) GetPatchBaselineWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatchBaselineWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetPatchBaselineWithContext), varargs...)
+}
+
+// GetServiceSetting mocks base method
+func (m *MockSSMClient) GetServiceSetting(arg0 *ssm.GetServiceSettingInput) (*ssm.GetServiceSettingOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceSetting", arg0)
+	ret0, _ := ret[0].(*ssm.GetServiceSettingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceSetting indicates an expected call of GetServiceSetting
+func (mr *MockSSMClientMockRecorder) GetServiceSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSetting", reflect.TypeOf((*MockSSMClient)(nil).GetServiceSetting), arg0)
+}
+
+// GetServiceSettingRequest mocks base method
+func (m *MockSSMClient) GetServiceSettingRequest(arg0 *ssm.GetServiceSettingInput) (*request.Request, *ssm.GetServiceSettingOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceSettingRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.GetServiceSettingOutput)
+	return ret0, ret1
+}
+
+// GetServiceSettingRequest indicates an expected call of GetServiceSettingRequest
+func (mr *MockSSMClientMockRecorder) GetServiceSettingRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSettingRequest", reflect.TypeOf((*MockSSMClient)(nil).GetServiceSettingRequest), arg0)
+}
+
+// GetServiceSettingWithContext mocks base method
+func (m *MockSSMClient) GetServiceSettingWithContext(arg0 context.Context, arg1 *ssm.GetServiceSettingInput, arg2 ...request.Option) (*ssm.GetServiceSettingOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServiceSettingWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.GetServiceSettingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceSettingWithContext indicates an expected call of GetServiceSettingWithContext
+func (mr *MockSSMClientMockRecorder) GetServiceSettingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSettingWithContext", reflect.TypeOf((*MockSSMClient)(nil).GetServiceSettingWithContext), varargs...)
+}
+
+// LabelParameterVersion mocks base method
+func (m *MockSSMClient) LabelParameterVersion(arg0 *ssm.LabelParameterVersionInput) (*ssm.LabelParameterVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LabelParameterVersion", arg0)
+	ret0, _ := ret[0].(*ssm.LabelParameterVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LabelParameterVersion indicates an expected call of LabelParameterVersion
+func (mr *MockSSMClientMockRecorder) LabelParameterVersion(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LabelParameterVersion", reflect.TypeOf((*MockSSMClient)(nil).LabelParameterVersion), arg0)
+}
+
+// LabelParameterVersionRequest mocks base method
+func (m *MockSSMClient) LabelParameterVersionRequest(arg0 *ssm.LabelParameterVersionInput) (*request.Request, *ssm.LabelParameterVersionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LabelParameterVersionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.LabelParameterVersionOutput)
+	return ret0, ret1
+}
+
+// LabelParameterVersionRequest indicates an expected call of LabelParameterVersionRequest
+func (mr *MockSSMClientMockRecorder) LabelParameterVersionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LabelParameterVersionRequest", reflect.TypeOf((*MockSSMClient)(nil).LabelParameterVersionRequest), arg0)
+}
+
+// LabelParameterVersionWithContext mocks base method
+func (m *MockSSMClient) LabelParameterVersionWithContext(arg0 context.Context, arg1 *ssm.LabelParameterVersionInput, arg2 ...request.Option) (*ssm.LabelParameterVersionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LabelParameterVersionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.LabelParameterVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LabelParameterVersionWithContext indicates an expected call of LabelParameterVersionWithContext
+func (mr *MockSSMClientMockRecorder) LabelParameterVersionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LabelParameterVersionWithContext", reflect.TypeOf((*MockSSMClient)(nil).LabelParameterVersionWithContext), varargs...)
+}
+
+// ListAssociationVersions mocks base method
+func (m *MockSSMClient) ListAssociationVersions(arg0 *ssm.ListAssociationVersionsInput) (*ssm.ListAssociationVersionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociationVersions", arg0)
+	ret0, _ := ret[0].(*ssm.ListAssociationVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssociationVersions indicates an expected call of ListAssociationVersions
+func (mr *MockSSMClientMockRecorder) ListAssociationVersions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationVersions", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationVersions), arg0)
+}
+
+// ListAssociationVersionsPages mocks base method
+func (m *MockSSMClient) ListAssociationVersionsPages(arg0 *ssm.ListAssociationVersionsInput, arg1 func(*ssm.ListAssociationVersionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociationVersionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAssociationVersionsPages indicates an expected call of ListAssociationVersionsPages
+func (mr *MockSSMClientMockRecorder) ListAssociationVersionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationVersionsPages", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationVersionsPages), arg0, arg1)
+}
+
+// ListAssociationVersionsPagesWithContext mocks base method
+func (m *MockSSMClient) ListAssociationVersionsPagesWithContext(arg0 context.Context, arg1 *ssm.ListAssociationVersionsInput, arg2 func(*ssm.ListAssociationVersionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAssociationVersionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAssociationVersionsPagesWithContext indicates an expected call of ListAssociationVersionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListAssociationVersionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationVersionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationVersionsPagesWithContext), varargs...)
+}
+
+// ListAssociationVersionsRequest mocks base method
+func (m *MockSSMClient) ListAssociationVersionsRequest(arg0 *ssm.ListAssociationVersionsInput) (*request.Request, *ssm.ListAssociationVersionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociationVersionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListAssociationVersionsOutput)
+	return ret0, ret1
+}
+
+// ListAssociationVersionsRequest indicates an expected call of ListAssociationVersionsRequest
+func (mr *MockSSMClientMockRecorder) ListAssociationVersionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationVersionsRequest", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationVersionsRequest), arg0)
+}
+
+// ListAssociationVersionsWithContext mocks base method
+func (m *MockSSMClient) ListAssociationVersionsWithContext(arg0 context.Context, arg1 *ssm.ListAssociationVersionsInput, arg2 ...request.Option) (*ssm.ListAssociationVersionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAssociationVersionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListAssociationVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssociationVersionsWithContext indicates an expected call of ListAssociationVersionsWithContext
+func (mr *MockSSMClientMockRecorder) ListAssociationVersionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationVersionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationVersionsWithContext), varargs...)
+}
+
+// ListAssociations mocks base method
+func (m *MockSSMClient) ListAssociations(arg0 *ssm.ListAssociationsInput) (*ssm.ListAssociationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociations", arg0)
+	ret0, _ := ret[0].(*ssm.ListAssociationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssociations indicates an expected call of ListAssociations
+func (mr *MockSSMClientMockRecorder) ListAssociations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociations", reflect.TypeOf((*MockSSMClient)(nil).ListAssociations), arg0)
+}
+
+// ListAssociationsPages mocks base method
+func (m *MockSSMClient) ListAssociationsPages(arg0 *ssm.ListAssociationsInput, arg1 func(*ssm.ListAssociationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAssociationsPages indicates an expected call of ListAssociationsPages
+func (mr *MockSSMClientMockRecorder) ListAssociationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationsPages", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationsPages), arg0, arg1)
+}
+
+// ListAssociationsPagesWithContext mocks base method
+func (m *MockSSMClient) ListAssociationsPagesWithContext(arg0 context.Context, arg1 *ssm.ListAssociationsInput, arg2 func(*ssm.ListAssociationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAssociationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAssociationsPagesWithContext indicates an expected call of ListAssociationsPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListAssociationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationsPagesWithContext), varargs...)
+}
+
+// ListAssociationsRequest mocks base method
+func (m *MockSSMClient) ListAssociationsRequest(arg0 *ssm.ListAssociationsInput) (*request.Request, *ssm.ListAssociationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListAssociationsOutput)
+	return ret0, ret1
+}
+
+// ListAssociationsRequest indicates an expected call of ListAssociationsRequest
+func (mr *MockSSMClientMockRecorder) ListAssociationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationsRequest", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationsRequest), arg0)
+}
+
+// ListAssociationsWithContext mocks base method
+func (m *MockSSMClient) ListAssociationsWithContext(arg0 context.Context, arg1 *ssm.ListAssociationsInput, arg2 ...request.Option) (*ssm.ListAssociationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAssociationsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListAssociationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssociationsWithContext indicates an expected call of ListAssociationsWithContext
+func (mr *MockSSMClientMockRecorder) ListAssociationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociationsWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListAssociationsWithContext), varargs...)
+}
+
+// ListCommandInvocations mocks base method
+func (m *MockSSMClient) ListCommandInvocations(arg0 *ssm.ListCommandInvocationsInput) (*ssm.ListCommandInvocationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommandInvocations", arg0)
+	ret0, _ := ret[0].(*ssm.ListCommandInvocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommandInvocations indicates an expected call of ListCommandInvocations
+func (mr *MockSSMClientMockRecorder) ListCommandInvocations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandInvocations", reflect.TypeOf((*MockSSMClient)(nil).ListCommandInvocations), arg0)
+}
+
+// ListCommandInvocationsPages mocks base method
+func (m *MockSSMClient) ListCommandInvocationsPages(arg0 *ssm.ListCommandInvocationsInput, arg1 func(*ssm.ListCommandInvocationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommandInvocationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCommandInvocationsPages indicates an expected call of ListCommandInvocationsPages
+func (mr *MockSSMClientMockRecorder) ListCommandInvocationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandInvocationsPages", reflect.TypeOf((*MockSSMClient)(nil).ListCommandInvocationsPages), arg0, arg1)
+}
+
+// ListCommandInvocationsPagesWithContext mocks base method
+func (m *MockSSMClient) ListCommandInvocationsPagesWithContext(arg0 context.Context, arg1 *ssm.ListCommandInvocationsInput, arg2 func(*ssm.ListCommandInvocationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCommandInvocationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCommandInvocationsPagesWithContext indicates an expected call of ListCommandInvocationsPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListCommandInvocationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandInvocationsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListCommandInvocationsPagesWithContext), varargs...)
+}
+
+// ListCommandInvocationsRequest mocks base method
+func (m *MockSSMClient) ListCommandInvocationsRequest(arg0 *ssm.ListCommandInvocationsInput) (*request.Request, *ssm.ListCommandInvocationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommandInvocationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListCommandInvocationsOutput)
+	return ret0, ret1
+}
+
+// ListCommandInvocationsRequest indicates an expected call of ListCommandInvocationsRequest
+func (mr *MockSSMClientMockRecorder) ListCommandInvocationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandInvocationsRequest", reflect.TypeOf((*MockSSMClient)(nil).ListCommandInvocationsRequest), arg0)
+}
+
+// ListCommandInvocationsWithContext mocks base method
+func (m *MockSSMClient) ListCommandInvocationsWithContext(arg0 context.Context, arg1 *ssm.ListCommandInvocationsInput, arg2 ...request.Option) (*ssm.ListCommandInvocationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCommandInvocationsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListCommandInvocationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommandInvocationsWithContext indicates an expected call of ListCommandInvocationsWithContext
+func (mr *MockSSMClientMockRecorder) ListCommandInvocationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandInvocationsWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListCommandInvocationsWithContext), varargs...)
+}
+
+// ListCommands mocks base method
+func (m *MockSSMClient) ListCommands(arg0 *ssm.ListCommandsInput) (*ssm.ListCommandsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommands", arg0)
+	ret0, _ := ret[0].(*ssm.ListCommandsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommands indicates an expected call of ListCommands
+func (mr *MockSSMClientMockRecorder) ListCommands(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommands", reflect.TypeOf((*MockSSMClient)(nil).ListCommands), arg0)
+}
+
+// ListCommandsPages mocks base method
+func (m *MockSSMClient) ListCommandsPages(arg0 *ssm.ListCommandsInput, arg1 func(*ssm.ListCommandsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommandsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCommandsPages indicates an expected call of ListCommandsPages
+func (mr *MockSSMClientMockRecorder) ListCommandsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandsPages", reflect.TypeOf((*MockSSMClient)(nil).ListCommandsPages), arg0, arg1)
+}
+
+// ListCommandsPagesWithContext mocks base method
+func (m *MockSSMClient) ListCommandsPagesWithContext(arg0 context.Context, arg1 *ssm.ListCommandsInput, arg2 func(*ssm.ListCommandsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCommandsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCommandsPagesWithContext indicates an expected call of ListCommandsPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListCommandsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListCommandsPagesWithContext), varargs...)
+}
+
+// ListCommandsRequest mocks base method
+func (m *MockSSMClient) ListCommandsRequest(arg0 *ssm.ListCommandsInput) (*request.Request, *ssm.ListCommandsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommandsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListCommandsOutput)
+	return ret0, ret1
+}
+
+// ListCommandsRequest indicates an expected call of ListCommandsRequest
+func (mr *MockSSMClientMockRecorder) ListCommandsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandsRequest", reflect.TypeOf((*MockSSMClient)(nil).ListCommandsRequest), arg0)
+}
+
+// ListCommandsWithContext mocks base method
+func (m *MockSSMClient) ListCommandsWithContext(arg0 context.Context, arg1 *ssm.ListCommandsInput, arg2 ...request.Option) (*ssm.ListCommandsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCommandsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListCommandsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommandsWithContext indicates an expected call of ListCommandsWithContext
+func (mr *MockSSMClientMockRecorder) ListCommandsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommandsWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListCommandsWithContext), varargs...)
+}
+
+// ListComplianceItems mocks base method
+func (m *MockSSMClient) ListComplianceItems(arg0 *ssm.ListComplianceItemsInput) (*ssm.ListComplianceItemsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceItems", arg0)
+	ret0, _ := ret[0].(*ssm.ListComplianceItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceItems indicates an expected call of ListComplianceItems
+func (mr *MockSSMClientMockRecorder) ListComplianceItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceItems", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceItems), arg0)
+}
+
+// ListComplianceItemsPages mocks base method
+func (m *MockSSMClient) ListComplianceItemsPages(arg0 *ssm.ListComplianceItemsInput, arg1 func(*ssm.ListComplianceItemsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceItemsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListComplianceItemsPages indicates an expected call of ListComplianceItemsPages
+func (mr *MockSSMClientMockRecorder) ListComplianceItemsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceItemsPages", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceItemsPages), arg0, arg1)
+}
+
+// ListComplianceItemsPagesWithContext mocks base method
+func (m *MockSSMClient) ListComplianceItemsPagesWithContext(arg0 context.Context, arg1 *ssm.ListComplianceItemsInput, arg2 func(*ssm.ListComplianceItemsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListComplianceItemsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListComplianceItemsPagesWithContext indicates an expected call of ListComplianceItemsPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListComplianceItemsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceItemsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceItemsPagesWithContext), varargs...)
+}
+
+// ListComplianceItemsRequest mocks base method
+func (m *MockSSMClient) ListComplianceItemsRequest(arg0 *ssm.ListComplianceItemsInput) (*request.Request, *ssm.ListComplianceItemsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceItemsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListComplianceItemsOutput)
+	return ret0, ret1
+}
+
+// ListComplianceItemsRequest indicates an expected call of ListComplianceItemsRequest
+func (mr *MockSSMClientMockRecorder) ListComplianceItemsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceItemsRequest", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceItemsRequest), arg0)
+}
+
+// ListComplianceItemsWithContext mocks base method
+func (m *MockSSMClient) ListComplianceItemsWithContext(arg0 context.Context, arg1 *ssm.ListComplianceItemsInput, arg2 ...request.Option) (*ssm.ListComplianceItemsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListComplianceItemsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListComplianceItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceItemsWithContext indicates an expected call of ListComplianceItemsWithContext
+func (mr *MockSSMClientMockRecorder) ListComplianceItemsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceItemsWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceItemsWithContext), varargs...)
+}
+
+// ListComplianceSummaries mocks base method
+func (m *MockSSMClient) ListComplianceSummaries(arg0 *ssm.ListComplianceSummariesInput) (*ssm.ListComplianceSummariesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceSummaries", arg0)
+	ret0, _ := ret[0].(*ssm.ListComplianceSummariesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceSummaries indicates an expected call of ListComplianceSummaries
+func (mr *MockSSMClientMockRecorder) ListComplianceSummaries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSummaries", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceSummaries), arg0)
+}
+
+// ListComplianceSummariesPages mocks base method
+func (m *MockSSMClient) ListComplianceSummariesPages(arg0 *ssm.ListComplianceSummariesInput, arg1 func(*ssm.ListComplianceSummariesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceSummariesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListComplianceSummariesPages indicates an expected call of ListComplianceSummariesPages
+func (mr *MockSSMClientMockRecorder) ListComplianceSummariesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSummariesPages", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceSummariesPages), arg0, arg1)
+}
+
+// ListComplianceSummariesPagesWithContext mocks base method
+func (m *MockSSMClient) ListComplianceSummariesPagesWithContext(arg0 context.Context, arg1 *ssm.ListComplianceSummariesInput, arg2 func(*ssm.ListComplianceSummariesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListComplianceSummariesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListComplianceSummariesPagesWithContext indicates an expected call of ListComplianceSummariesPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListComplianceSummariesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSummariesPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceSummariesPagesWithContext), varargs...)
+}
+
+// ListComplianceSummariesRequest mocks base method
+func (m *MockSSMClient) ListComplianceSummariesRequest(arg0 *ssm.ListComplianceSummariesInput) (*request.Request, *ssm.ListComplianceSummariesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceSummariesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListComplianceSummariesOutput)
+	return ret0, ret1
+}
+
+// ListComplianceSummariesRequest indicates an expected call of ListComplianceSummariesRequest
+func (mr *MockSSMClientMockRecorder) ListComplianceSummariesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSummariesRequest", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceSummariesRequest), arg0)
+}
+
+// ListComplianceSummariesWithContext mocks base method
+func (m *MockSSMClient) ListComplianceSummariesWithContext(arg0 context.Context, arg1 *ssm.ListComplianceSummariesInput, arg2 ...request.Option) (*ssm.ListComplianceSummariesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListComplianceSummariesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListComplianceSummariesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceSummariesWithContext indicates an expected call of ListComplianceSummariesWithContext
+func (mr *MockSSMClientMockRecorder) ListComplianceSummariesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSummariesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListComplianceSummariesWithContext), varargs...)
+}
+
+// ListDocumentVersions mocks base method
+func (m *MockSSMClient) ListDocumentVersions(arg0 *ssm.ListDocumentVersionsInput) (*ssm.ListDocumentVersionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentVersions", arg0)
+	ret0, _ := ret[0].(*ssm.ListDocumentVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentVersions indicates an expected call of ListDocumentVersions
+func (mr *MockSSMClientMockRecorder) ListDocumentVersions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentVersions", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentVersions), arg0)
+}
+
+// ListDocumentVersionsPages mocks base method
+func (m *MockSSMClient) ListDocumentVersionsPages(arg0 *ssm.ListDocumentVersionsInput, arg1 func(*ssm.ListDocumentVersionsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentVersionsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDocumentVersionsPages indicates an expected call of ListDocumentVersionsPages
+func (mr *MockSSMClientMockRecorder) ListDocumentVersionsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentVersionsPages", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentVersionsPages), arg0, arg1)
+}
+
+// ListDocumentVersionsPagesWithContext mocks base method
+func (m *MockSSMClient) ListDocumentVersionsPagesWithContext(arg0 context.Context, arg1 *ssm.ListDocumentVersionsInput, arg2 func(*ssm.ListDocumentVersionsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDocumentVersionsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDocumentVersionsPagesWithContext indicates an expected call of ListDocumentVersionsPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListDocumentVersionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentVersionsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentVersionsPagesWithContext), varargs...)
+}
+
+// ListDocumentVersionsRequest mocks base method
+func (m *MockSSMClient) ListDocumentVersionsRequest(arg0 *ssm.ListDocumentVersionsInput) (*request.Request, *ssm.ListDocumentVersionsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentVersionsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListDocumentVersionsOutput)
+	return ret0, ret1
+}
+
+// ListDocumentVersionsRequest indicates an expected call of ListDocumentVersionsRequest
+func (mr *MockSSMClientMockRecorder) ListDocumentVersionsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentVersionsRequest", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentVersionsRequest), arg0)
+}
+
+// ListDocumentVersionsWithContext mocks base method
+func (m *MockSSMClient) ListDocumentVersionsWithContext(arg0 context.Context, arg1 *ssm.ListDocumentVersionsInput, arg2 ...request.Option) (*ssm.ListDocumentVersionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDocumentVersionsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListDocumentVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentVersionsWithContext indicates an expected call of ListDocumentVersionsWithContext
+func (mr *MockSSMClientMockRecorder) ListDocumentVersionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentVersionsWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentVersionsWithContext), varargs...)
+}
+
+// ListDocuments mocks base method
+func (m *MockSSMClient) ListDocuments(arg0 *ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocuments", arg0)
+	ret0, _ := ret[0].(*ssm.ListDocumentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocuments indicates an expected call of ListDocuments
+func (mr *MockSSMClientMockRecorder) ListDocuments(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocuments", reflect.TypeOf((*MockSSMClient)(nil).ListDocuments), arg0)
+}
+
+// ListDocumentsPages mocks base method
+func (m *MockSSMClient) ListDocumentsPages(arg0 *ssm.ListDocumentsInput, arg1 func(*ssm.ListDocumentsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDocumentsPages indicates an expected call of ListDocumentsPages
+func (mr *MockSSMClientMockRecorder) ListDocumentsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsPages", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentsPages), arg0, arg1)
+}
+
+// ListDocumentsPagesWithContext mocks base method
+func (m *MockSSMClient) ListDocumentsPagesWithContext(arg0 context.Context, arg1 *ssm.ListDocumentsInput, arg2 func(*ssm.ListDocumentsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDocumentsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDocumentsPagesWithContext indicates an expected call of ListDocumentsPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListDocumentsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentsPagesWithContext), varargs...)
+}
+
+// ListDocumentsRequest mocks base method
+func (m *MockSSMClient) ListDocumentsRequest(arg0 *ssm.ListDocumentsInput) (*request.Request, *ssm.ListDocumentsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDocumentsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListDocumentsOutput)
+	return ret0, ret1
+}
+
+// ListDocumentsRequest indicates an expected call of ListDocumentsRequest
+func (mr *MockSSMClientMockRecorder) ListDocumentsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsRequest", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentsRequest), arg0)
+}
+
+// ListDocumentsWithContext mocks base method
+func (m *MockSSMClient) ListDocumentsWithContext(arg0 context.Context, arg1 *ssm.ListDocumentsInput, arg2 ...request.Option) (*ssm.ListDocumentsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDocumentsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListDocumentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDocumentsWithContext indicates an expected call of ListDocumentsWithContext
+func (mr *MockSSMClientMockRecorder) ListDocumentsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDocumentsWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListDocumentsWithContext), varargs...)
+}
+
+// ListInventoryEntries mocks base method
+func (m *MockSSMClient) ListInventoryEntries(arg0 *ssm.ListInventoryEntriesInput) (*ssm.ListInventoryEntriesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInventoryEntries", arg0)
+	ret0, _ := ret[0].(*ssm.ListInventoryEntriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInventoryEntries indicates an expected call of ListInventoryEntries
+func (mr *MockSSMClientMockRecorder) ListInventoryEntries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInventoryEntries", reflect.TypeOf((*MockSSMClient)(nil).ListInventoryEntries), arg0)
+}
+
+// ListInventoryEntriesRequest mocks base method
+func (m *MockSSMClient) ListInventoryEntriesRequest(arg0 *ssm.ListInventoryEntriesInput) (*request.Request, *ssm.ListInventoryEntriesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInventoryEntriesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListInventoryEntriesOutput)
+	return ret0, ret1
+}
+
+// ListInventoryEntriesRequest indicates an expected call of ListInventoryEntriesRequest
+func (mr *MockSSMClientMockRecorder) ListInventoryEntriesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInventoryEntriesRequest", reflect.TypeOf((*MockSSMClient)(nil).ListInventoryEntriesRequest), arg0)
+}
+
+// ListInventoryEntriesWithContext mocks base method
+func (m *MockSSMClient) ListInventoryEntriesWithContext(arg0 context.Context, arg1 *ssm.ListInventoryEntriesInput, arg2 ...request.Option) (*ssm.ListInventoryEntriesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInventoryEntriesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListInventoryEntriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInventoryEntriesWithContext indicates an expected call of ListInventoryEntriesWithContext
+func (mr *MockSSMClientMockRecorder) ListInventoryEntriesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInventoryEntriesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListInventoryEntriesWithContext), varargs...)
+}
+
+// ListResourceComplianceSummaries mocks base method
+func (m *MockSSMClient) ListResourceComplianceSummaries(arg0 *ssm.ListResourceComplianceSummariesInput) (*ssm.ListResourceComplianceSummariesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceComplianceSummaries", arg0)
+	ret0, _ := ret[0].(*ssm.ListResourceComplianceSummariesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceComplianceSummaries indicates an expected call of ListResourceComplianceSummaries
+func (mr *MockSSMClientMockRecorder) ListResourceComplianceSummaries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceComplianceSummaries", reflect.TypeOf((*MockSSMClient)(nil).ListResourceComplianceSummaries), arg0)
+}
+
+// ListResourceComplianceSummariesPages mocks base method
+func (m *MockSSMClient) ListResourceComplianceSummariesPages(arg0 *ssm.ListResourceComplianceSummariesInput, arg1 func(*ssm.ListResourceComplianceSummariesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceComplianceSummariesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceComplianceSummariesPages indicates an expected call of ListResourceComplianceSummariesPages
+func (mr *MockSSMClientMockRecorder) ListResourceComplianceSummariesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceComplianceSummariesPages", reflect.TypeOf((*MockSSMClient)(nil).ListResourceComplianceSummariesPages), arg0, arg1)
+}
+
+// ListResourceComplianceSummariesPagesWithContext mocks base method
+func (m *MockSSMClient) ListResourceComplianceSummariesPagesWithContext(arg0 context.Context, arg1 *ssm.ListResourceComplianceSummariesInput, arg2 func(*ssm.ListResourceComplianceSummariesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceComplianceSummariesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceComplianceSummariesPagesWithContext indicates an expected call of ListResourceComplianceSummariesPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListResourceComplianceSummariesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceComplianceSummariesPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListResourceComplianceSummariesPagesWithContext), varargs...)
+}
+
+// ListResourceComplianceSummariesRequest mocks base method
+func (m *MockSSMClient) ListResourceComplianceSummariesRequest(arg0 *ssm.ListResourceComplianceSummariesInput) (*request.Request, *ssm.ListResourceComplianceSummariesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceComplianceSummariesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListResourceComplianceSummariesOutput)
+	return ret0, ret1
+}
+
+// ListResourceComplianceSummariesRequest indicates an expected call of ListResourceComplianceSummariesRequest
+func (mr *MockSSMClientMockRecorder) ListResourceComplianceSummariesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceComplianceSummariesRequest", reflect.TypeOf((*MockSSMClient)(nil).ListResourceComplianceSummariesRequest), arg0)
+}
+
+// ListResourceComplianceSummariesWithContext mocks base method
+func (m *MockSSMClient) ListResourceComplianceSummariesWithContext(arg0 context.Context, arg1 *ssm.ListResourceComplianceSummariesInput, arg2 ...request.Option) (*ssm.ListResourceComplianceSummariesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceComplianceSummariesWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListResourceComplianceSummariesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceComplianceSummariesWithContext indicates an expected call of ListResourceComplianceSummariesWithContext
+func (mr *MockSSMClientMockRecorder) ListResourceComplianceSummariesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceComplianceSummariesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListResourceComplianceSummariesWithContext), varargs...)
+}
+
+// ListResourceDataSync mocks base method
+func (m *MockSSMClient) ListResourceDataSync(arg0 *ssm.ListResourceDataSyncInput) (*ssm.ListResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceDataSync", arg0)
+	ret0, _ := ret[0].(*ssm.ListResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceDataSync indicates an expected call of ListResourceDataSync
+func (mr *MockSSMClientMockRecorder) ListResourceDataSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDataSync", reflect.TypeOf((*MockSSMClient)(nil).ListResourceDataSync), arg0)
+}
+
+// ListResourceDataSyncPages mocks base method
+func (m *MockSSMClient) ListResourceDataSyncPages(arg0 *ssm.ListResourceDataSyncInput, arg1 func(*ssm.ListResourceDataSyncOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceDataSyncPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceDataSyncPages indicates an expected call of ListResourceDataSyncPages
+func (mr *MockSSMClientMockRecorder) ListResourceDataSyncPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDataSyncPages", reflect.TypeOf((*MockSSMClient)(nil).ListResourceDataSyncPages), arg0, arg1)
+}
+
+// ListResourceDataSyncPagesWithContext mocks base method
+func (m *MockSSMClient) ListResourceDataSyncPagesWithContext(arg0 context.Context, arg1 *ssm.ListResourceDataSyncInput, arg2 func(*ssm.ListResourceDataSyncOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceDataSyncPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceDataSyncPagesWithContext indicates an expected call of ListResourceDataSyncPagesWithContext
+func (mr *MockSSMClientMockRecorder) ListResourceDataSyncPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDataSyncPagesWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListResourceDataSyncPagesWithContext), varargs...)
+}
+
+// ListResourceDataSyncRequest mocks base method
+func (m *MockSSMClient) ListResourceDataSyncRequest(arg0 *ssm.ListResourceDataSyncInput) (*request.Request, *ssm.ListResourceDataSyncOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceDataSyncRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListResourceDataSyncOutput)
+	return ret0, ret1
+}
+
+// ListResourceDataSyncRequest indicates an expected call of ListResourceDataSyncRequest
+func (mr *MockSSMClientMockRecorder) ListResourceDataSyncRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDataSyncRequest", reflect.TypeOf((*MockSSMClient)(nil).ListResourceDataSyncRequest), arg0)
+}
+
+// ListResourceDataSyncWithContext mocks base method
+func (m *MockSSMClient) ListResourceDataSyncWithContext(arg0 context.Context, arg1 *ssm.ListResourceDataSyncInput, arg2 ...request.Option) (*ssm.ListResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceDataSyncWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceDataSyncWithContext indicates an expected call of ListResourceDataSyncWithContext
+func (mr *MockSSMClientMockRecorder) ListResourceDataSyncWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDataSyncWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListResourceDataSyncWithContext), varargs...)
+}
+
+// ListTagsForResource mocks base method
+func (m *MockSSMClient) ListTagsForResource(arg0 *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResource", arg0)
+	ret0, _ := ret[0].(*ssm.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResource indicates an expected call of ListTagsForResource
+func (mr *MockSSMClientMockRecorder) ListTagsForResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*MockSSMClient)(nil).ListTagsForResource), arg0)
+}
+
+// ListTagsForResourceRequest mocks base method
+func (m *MockSSMClient) ListTagsForResourceRequest(arg0 *ssm.ListTagsForResourceInput) (*request.Request, *ssm.ListTagsForResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ListTagsForResourceOutput)
+	return ret0, ret1
+}
+
+// ListTagsForResourceRequest indicates an expected call of ListTagsForResourceRequest
+func (mr *MockSSMClientMockRecorder) ListTagsForResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceRequest", reflect.TypeOf((*MockSSMClient)(nil).ListTagsForResourceRequest), arg0)
+}
+
+// ListTagsForResourceWithContext mocks base method
+func (m *MockSSMClient) ListTagsForResourceWithContext(arg0 context.Context, arg1 *ssm.ListTagsForResourceInput, arg2 ...request.Option) (*ssm.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsForResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResourceWithContext indicates an expected call of ListTagsForResourceWithContext
+func (mr *MockSSMClientMockRecorder) ListTagsForResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceWithContext", reflect.TypeOf((*MockSSMClient)(nil).ListTagsForResourceWithContext), varargs...)
+}
+
+// ModifyDocumentPermission mocks base method
+func (m *MockSSMClient) ModifyDocumentPermission(arg0 *ssm.ModifyDocumentPermissionInput) (*ssm.ModifyDocumentPermissionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyDocumentPermission", arg0)
+	ret0, _ := ret[0].(*ssm.ModifyDocumentPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyDocumentPermission indicates an expected call of ModifyDocumentPermission
+func (mr *MockSSMClientMockRecorder) ModifyDocumentPermission(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDocumentPermission", reflect.TypeOf((*MockSSMClient)(nil).ModifyDocumentPermission), arg0)
+}
+
+// ModifyDocumentPermissionRequest mocks base method
+func (m *MockSSMClient) ModifyDocumentPermissionRequest(arg0 *ssm.ModifyDocumentPermissionInput) (*request.Request, *ssm.ModifyDocumentPermissionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyDocumentPermissionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ModifyDocumentPermissionOutput)
+	return ret0, ret1
+}
+
+// ModifyDocumentPermissionRequest indicates an expected call of ModifyDocumentPermissionRequest
+func (mr *MockSSMClientMockRecorder) ModifyDocumentPermissionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDocumentPermissionRequest", reflect.TypeOf((*MockSSMClient)(nil).ModifyDocumentPermissionRequest), arg0)
+}
+
+// ModifyDocumentPermissionWithContext mocks base method
+func (m *MockSSMClient) ModifyDocumentPermissionWithContext(arg0 context.Context, arg1 *ssm.ModifyDocumentPermissionInput, arg2 ...request.Option) (*ssm.ModifyDocumentPermissionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyDocumentPermissionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ModifyDocumentPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyDocumentPermissionWithContext indicates an expected call of ModifyDocumentPermissionWithContext
+func (mr *MockSSMClientMockRecorder) ModifyDocumentPermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDocumentPermissionWithContext", reflect.TypeOf((*MockSSMClient)(nil).ModifyDocumentPermissionWithContext), varargs...)
+}
+
+// PutComplianceItems mocks base method
+func (m *MockSSMClient) PutComplianceItems(arg0 *ssm.PutComplianceItemsInput) (*ssm.PutComplianceItemsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutComplianceItems", arg0)
+	ret0, _ := ret[0].(*ssm.PutComplianceItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutComplianceItems indicates an expected call of PutComplianceItems
+func (mr *MockSSMClientMockRecorder) PutComplianceItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutComplianceItems", reflect.TypeOf((*MockSSMClient)(nil).PutComplianceItems), arg0)
+}
+
+// PutComplianceItemsRequest mocks base method
+func (m *MockSSMClient) PutComplianceItemsRequest(arg0 *ssm.PutComplianceItemsInput) (*request.Request, *ssm.PutComplianceItemsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutComplianceItemsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.PutComplianceItemsOutput)
+	return ret0, ret1
+}
+
+// PutComplianceItemsRequest indicates an expected call of PutComplianceItemsRequest
+func (mr *MockSSMClientMockRecorder) PutComplianceItemsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutComplianceItemsRequest", reflect.TypeOf((*MockSSMClient)(nil).PutComplianceItemsRequest), arg0)
+}
+
+// PutComplianceItemsWithContext mocks base method
+func (m *MockSSMClient) PutComplianceItemsWithContext(arg0 context.Context, arg1 *ssm.PutComplianceItemsInput, arg2 ...request.Option) (*ssm.PutComplianceItemsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutComplianceItemsWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.PutComplianceItemsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutComplianceItemsWithContext indicates an expected call of PutComplianceItemsWithContext
+func (mr *MockSSMClientMockRecorder) PutComplianceItemsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutComplianceItemsWithContext", reflect.TypeOf((*MockSSMClient)(nil).PutComplianceItemsWithContext), varargs...)
+}
+
+// PutInventory mocks base method
+func (m *MockSSMClient) PutInventory(arg0 *ssm.PutInventoryInput) (*ssm.PutInventoryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutInventory", arg0)
+	ret0, _ := ret[0].(*ssm.PutInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutInventory indicates an expected call of PutInventory
+func (mr *MockSSMClientMockRecorder) PutInventory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutInventory", reflect.TypeOf((*MockSSMClient)(nil).PutInventory), arg0)
+}
+
+// PutInventoryRequest mocks base method
+func (m *MockSSMClient) PutInventoryRequest(arg0 *ssm.PutInventoryInput) (*request.Request, *ssm.PutInventoryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutInventoryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.PutInventoryOutput)
+	return ret0, ret1
+}
+
+// PutInventoryRequest indicates an expected call of PutInventoryRequest
+func (mr *MockSSMClientMockRecorder) PutInventoryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutInventoryRequest", reflect.TypeOf((*MockSSMClient)(nil).PutInventoryRequest), arg0)
+}
+
+// PutInventoryWithContext mocks base method
+func (m *MockSSMClient) PutInventoryWithContext(arg0 context.Context, arg1 *ssm.PutInventoryInput, arg2 ...request.Option) (*ssm.PutInventoryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutInventoryWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.PutInventoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutInventoryWithContext indicates an expected call of PutInventoryWithContext
+func (mr *MockSSMClientMockRecorder) PutInventoryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutInventoryWithContext", reflect.TypeOf((*MockSSMClient)(nil).PutInventoryWithContext), varargs...)
+}
+
+// PutParameter mocks base method
+func (m *MockSSMClient) PutParameter(arg0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutParameter", arg0)
+	ret0, _ := ret[0].(*ssm.PutParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutParameter indicates an expected call of PutParameter
+func (mr *MockSSMClientMockRecorder) PutParameter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutParameter", reflect.TypeOf((*MockSSMClient)(nil).PutParameter), arg0)
+}
+
+// PutParameterRequest mocks base method
+func (m *MockSSMClient) PutParameterRequest(arg0 *ssm.PutParameterInput) (*request.Request, *ssm.PutParameterOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutParameterRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.PutParameterOutput)
+	return ret0, ret1
+}
+
+// PutParameterRequest indicates an expected call of PutParameterRequest
+func (mr *MockSSMClientMockRecorder) PutParameterRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutParameterRequest", reflect.TypeOf((*MockSSMClient)(nil).PutParameterRequest), arg0)
+}
+
+// PutParameterWithContext mocks base method
+func (m *MockSSMClient) PutParameterWithContext(arg0 context.Context, arg1 *ssm.PutParameterInput, arg2 ...request.Option) (*ssm.PutParameterOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutParameterWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.PutParameterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutParameterWithContext indicates an expected call of PutParameterWithContext
+func (mr *MockSSMClientMockRecorder) PutParameterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutParameterWithContext", reflect.TypeOf((*MockSSMClient)(nil).PutParameterWithContext), varargs...)
+}
+
+// RegisterDefaultPatchBaseline mocks base method
+func (m *MockSSMClient) RegisterDefaultPatchBaseline(arg0 *ssm.RegisterDefaultPatchBaselineInput) (*ssm.RegisterDefaultPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDefaultPatchBaseline", arg0)
+	ret0, _ := ret[0].(*ssm.RegisterDefaultPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDefaultPatchBaseline indicates an expected call of RegisterDefaultPatchBaseline
+func (mr *MockSSMClientMockRecorder) RegisterDefaultPatchBaseline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDefaultPatchBaseline", reflect.TypeOf((*MockSSMClient)(nil).RegisterDefaultPatchBaseline), arg0)
+}
+
+// RegisterDefaultPatchBaselineRequest mocks base method
+func (m *MockSSMClient) RegisterDefaultPatchBaselineRequest(arg0 *ssm.RegisterDefaultPatchBaselineInput) (*request.Request, *ssm.RegisterDefaultPatchBaselineOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDefaultPatchBaselineRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterDefaultPatchBaselineOutput)
+	return ret0, ret1
+}
+
+// RegisterDefaultPatchBaselineRequest indicates an expected call of RegisterDefaultPatchBaselineRequest
+func (mr *MockSSMClientMockRecorder) RegisterDefaultPatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDefaultPatchBaselineRequest", reflect.TypeOf((*MockSSMClient)(nil).RegisterDefaultPatchBaselineRequest), arg0)
+}
+
+// RegisterDefaultPatchBaselineWithContext mocks base method
+func (m *MockSSMClient) RegisterDefaultPatchBaselineWithContext(arg0 context.Context, arg1 *ssm.RegisterDefaultPatchBaselineInput, arg2 ...request.Option) (*ssm.RegisterDefaultPatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterDefaultPatchBaselineWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.RegisterDefaultPatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDefaultPatchBaselineWithContext indicates an expected call of RegisterDefaultPatchBaselineWithContext
+func (mr *MockSSMClientMockRecorder) RegisterDefaultPatchBaselineWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDefaultPatchBaselineWithContext", reflect.TypeOf((*MockSSMClient)(nil).RegisterDefaultPatchBaselineWithContext), varargs...)
+}
+
+// RegisterPatchBaselineForPatchGroup mocks base method
+func (m *MockSSMClient) RegisterPatchBaselineForPatchGroup(arg0 *ssm.RegisterPatchBaselineForPatchGroupInput) (*ssm.RegisterPatchBaselineForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterPatchBaselineForPatchGroup", arg0)
+	ret0, _ := ret[0].(*ssm.RegisterPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterPatchBaselineForPatchGroup indicates an expected call of RegisterPatchBaselineForPatchGroup
+func (mr *MockSSMClientMockRecorder) RegisterPatchBaselineForPatchGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPatchBaselineForPatchGroup", reflect.TypeOf((*MockSSMClient)(nil).RegisterPatchBaselineForPatchGroup), arg0)
+}
+
+// RegisterPatchBaselineForPatchGroupRequest mocks base method
+func (m *MockSSMClient) RegisterPatchBaselineForPatchGroupRequest(arg0 *ssm.RegisterPatchBaselineForPatchGroupInput) (*request.Request, *ssm.RegisterPatchBaselineForPatchGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterPatchBaselineForPatchGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterPatchBaselineForPatchGroupOutput)
+	return ret0, ret1
+}
+
+// RegisterPatchBaselineForPatchGroupRequest indicates an expected call of RegisterPatchBaselineForPatchGroupRequest
+func (mr *MockSSMClientMockRecorder) RegisterPatchBaselineForPatchGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPatchBaselineForPatchGroupRequest", reflect.TypeOf((*MockSSMClient)(nil).RegisterPatchBaselineForPatchGroupRequest), arg0)
+}
+
+// RegisterPatchBaselineForPatchGroupWithContext mocks base method
+func (m *MockSSMClient) RegisterPatchBaselineForPatchGroupWithContext(arg0 context.Context, arg1 *ssm.RegisterPatchBaselineForPatchGroupInput, arg2 ...request.Option) (*ssm.RegisterPatchBaselineForPatchGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterPatchBaselineForPatchGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.RegisterPatchBaselineForPatchGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterPatchBaselineForPatchGroupWithContext indicates an expected call of RegisterPatchBaselineForPatchGroupWithContext
+func (mr *MockSSMClientMockRecorder) RegisterPatchBaselineForPatchGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPatchBaselineForPatchGroupWithContext", reflect.TypeOf((*MockSSMClient)(nil).RegisterPatchBaselineForPatchGroupWithContext), varargs...)
+}
+
+// RegisterTargetWithMaintenanceWindow mocks base method
+func (m *MockSSMClient) RegisterTargetWithMaintenanceWindow(arg0 *ssm.RegisterTargetWithMaintenanceWindowInput) (*ssm.RegisterTargetWithMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTargetWithMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.RegisterTargetWithMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTargetWithMaintenanceWindow indicates an expected call of RegisterTargetWithMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) RegisterTargetWithMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTargetWithMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).RegisterTargetWithMaintenanceWindow), arg0)
+}
+
+// RegisterTargetWithMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) RegisterTargetWithMaintenanceWindowRequest(arg0 *ssm.RegisterTargetWithMaintenanceWindowInput) (*request.Request, *ssm.RegisterTargetWithMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTargetWithMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterTargetWithMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// RegisterTargetWithMaintenanceWindowRequest indicates an expected call of RegisterTargetWithMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) RegisterTargetWithMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTargetWithMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).RegisterTargetWithMaintenanceWindowRequest), arg0)
+}
+
+// RegisterTargetWithMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) RegisterTargetWithMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.RegisterTargetWithMaintenanceWindowInput, arg2 ...request.Option) (*ssm.RegisterTargetWithMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterTargetWithMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.RegisterTargetWithMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTargetWithMaintenanceWindowWithContext indicates an expected call of RegisterTargetWithMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) RegisterTargetWithMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTargetWithMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).RegisterTargetWithMaintenanceWindowWithContext), varargs...)
+}
+
+// RegisterTaskWithMaintenanceWindow mocks base method
+func (m *MockSSMClient) RegisterTaskWithMaintenanceWindow(arg0 *ssm.RegisterTaskWithMaintenanceWindowInput) (*ssm.RegisterTaskWithMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTaskWithMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.RegisterTaskWithMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTaskWithMaintenanceWindow indicates an expected call of RegisterTaskWithMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) RegisterTaskWithMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTaskWithMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).RegisterTaskWithMaintenanceWindow), arg0)
+}
+
+// RegisterTaskWithMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) RegisterTaskWithMaintenanceWindowRequest(arg0 *ssm.RegisterTaskWithMaintenanceWindowInput) (*request.Request, *ssm.RegisterTaskWithMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTaskWithMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RegisterTaskWithMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// RegisterTaskWithMaintenanceWindowRequest indicates an expected call of RegisterTaskWithMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) RegisterTaskWithMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTaskWithMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).RegisterTaskWithMaintenanceWindowRequest), arg0)
+}
+
+// RegisterTaskWithMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) RegisterTaskWithMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.RegisterTaskWithMaintenanceWindowInput, arg2 ...request.Option) (*ssm.RegisterTaskWithMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterTaskWithMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.RegisterTaskWithMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTaskWithMaintenanceWindowWithContext indicates an expected call of RegisterTaskWithMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) RegisterTaskWithMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTaskWithMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).RegisterTaskWithMaintenanceWindowWithContext), varargs...)
+}
+
+// RemoveTagsFromResource mocks base method
+func (m *MockSSMClient) RemoveTagsFromResource(arg0 *ssm.RemoveTagsFromResourceInput) (*ssm.RemoveTagsFromResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagsFromResource", arg0)
+	ret0, _ := ret[0].(*ssm.RemoveTagsFromResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTagsFromResource indicates an expected call of RemoveTagsFromResource
+func (mr *MockSSMClientMockRecorder) RemoveTagsFromResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagsFromResource", reflect.TypeOf((*MockSSMClient)(nil).RemoveTagsFromResource), arg0)
+}
+
+// RemoveTagsFromResourceRequest mocks base method
+func (m *MockSSMClient) RemoveTagsFromResourceRequest(arg0 *ssm.RemoveTagsFromResourceInput) (*request.Request, *ssm.RemoveTagsFromResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagsFromResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.RemoveTagsFromResourceOutput)
+	return ret0, ret1
+}
+
+// RemoveTagsFromResourceRequest indicates an expected call of RemoveTagsFromResourceRequest
+func (mr *MockSSMClientMockRecorder) RemoveTagsFromResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagsFromResourceRequest", reflect.TypeOf((*MockSSMClient)(nil).RemoveTagsFromResourceRequest), arg0)
+}
+
+// RemoveTagsFromResourceWithContext mocks base method
+func (m *MockSSMClient) RemoveTagsFromResourceWithContext(arg0 context.Context, arg1 *ssm.RemoveTagsFromResourceInput, arg2 ...request.Option) (*ssm.RemoveTagsFromResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTagsFromResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.RemoveTagsFromResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTagsFromResourceWithContext indicates an expected call of RemoveTagsFromResourceWithContext
+func (mr *MockSSMClientMockRecorder) RemoveTagsFromResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagsFromResourceWithContext", reflect.TypeOf((*MockSSMClient)(nil).RemoveTagsFromResourceWithContext), varargs...)
+}
+
+// ResetServiceSetting mocks base method
+func (m *MockSSMClient) ResetServiceSetting(arg0 *ssm.ResetServiceSettingInput) (*ssm.ResetServiceSettingOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetServiceSetting", arg0)
+	ret0, _ := ret[0].(*ssm.ResetServiceSettingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetServiceSetting indicates an expected call of ResetServiceSetting
+func (mr *MockSSMClientMockRecorder) ResetServiceSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetServiceSetting", reflect.TypeOf((*MockSSMClient)(nil).ResetServiceSetting), arg0)
+}
+
+// ResetServiceSettingRequest mocks base method
+func (m *MockSSMClient) ResetServiceSettingRequest(arg0 *ssm.ResetServiceSettingInput) (*request.Request, *ssm.ResetServiceSettingOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetServiceSettingRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ResetServiceSettingOutput)
+	return ret0, ret1
+}
+
+// ResetServiceSettingRequest indicates an expected call of ResetServiceSettingRequest
+func (mr *MockSSMClientMockRecorder) ResetServiceSettingRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetServiceSettingRequest", reflect.TypeOf((*MockSSMClient)(nil).ResetServiceSettingRequest), arg0)
+}
+
+// ResetServiceSettingWithContext mocks base method
+func (m *MockSSMClient) ResetServiceSettingWithContext(arg0 context.Context, arg1 *ssm.ResetServiceSettingInput, arg2 ...request.Option) (*ssm.ResetServiceSettingOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetServiceSettingWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ResetServiceSettingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetServiceSettingWithContext indicates an expected call of ResetServiceSettingWithContext
+func (mr *MockSSMClientMockRecorder) ResetServiceSettingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetServiceSettingWithContext", reflect.TypeOf((*MockSSMClient)(nil).ResetServiceSettingWithContext), varargs...)
+}
+
+// ResumeSession mocks base method
+func (m *MockSSMClient) ResumeSession(arg0 *ssm.ResumeSessionInput) (*ssm.ResumeSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSession", arg0)
+	ret0, _ := ret[0].(*ssm.ResumeSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSession indicates an expected call of ResumeSession
+func (mr *MockSSMClientMockRecorder) ResumeSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSession", reflect.TypeOf((*MockSSMClient)(nil).ResumeSession), arg0)
+}
+
+// ResumeSessionRequest mocks base method
+func (m *MockSSMClient) ResumeSessionRequest(arg0 *ssm.ResumeSessionInput) (*request.Request, *ssm.ResumeSessionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSessionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.ResumeSessionOutput)
+	return ret0, ret1
+}
+
+// ResumeSessionRequest indicates an expected call of ResumeSessionRequest
+func (mr *MockSSMClientMockRecorder) ResumeSessionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSessionRequest", reflect.TypeOf((*MockSSMClient)(nil).ResumeSessionRequest), arg0)
+}
+
+// ResumeSessionWithContext mocks base method
+func (m *MockSSMClient) ResumeSessionWithContext(arg0 context.Context, arg1 *ssm.ResumeSessionInput, arg2 ...request.Option) (*ssm.ResumeSessionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeSessionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.ResumeSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSessionWithContext indicates an expected call of ResumeSessionWithContext
+func (mr *MockSSMClientMockRecorder) ResumeSessionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSessionWithContext", reflect.TypeOf((*MockSSMClient)(nil).ResumeSessionWithContext), varargs...)
+}
+
+// SendAutomationSignal mocks base method
+func (m *MockSSMClient) SendAutomationSignal(arg0 *ssm.SendAutomationSignalInput) (*ssm.SendAutomationSignalOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAutomationSignal", arg0)
+	ret0, _ := ret[0].(*ssm.SendAutomationSignalOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendAutomationSignal indicates an expected call of SendAutomationSignal
+func (mr *MockSSMClientMockRecorder) SendAutomationSignal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAutomationSignal", reflect.TypeOf((*MockSSMClient)(nil).SendAutomationSignal), arg0)
+}
+
+// SendAutomationSignalRequest mocks base method
+func (m *MockSSMClient) SendAutomationSignalRequest(arg0 *ssm.SendAutomationSignalInput) (*request.Request, *ssm.SendAutomationSignalOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAutomationSignalRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.SendAutomationSignalOutput)
+	return ret0, ret1
+}
+
+// SendAutomationSignalRequest indicates an expected call of SendAutomationSignalRequest
+func (mr *MockSSMClientMockRecorder) SendAutomationSignalRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAutomationSignalRequest", reflect.TypeOf((*MockSSMClient)(nil).SendAutomationSignalRequest), arg0)
+}
+
+// SendAutomationSignalWithContext mocks base method
+func (m *MockSSMClient) SendAutomationSignalWithContext(arg0 context.Context, arg1 *ssm.SendAutomationSignalInput, arg2 ...request.Option) (*ssm.SendAutomationSignalOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendAutomationSignalWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.SendAutomationSignalOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendAutomationSignalWithContext indicates an expected call of SendAutomationSignalWithContext
+func (mr *MockSSMClientMockRecorder) SendAutomationSignalWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAutomationSignalWithContext", reflect.TypeOf((*MockSSMClient)(nil).SendAutomationSignalWithContext), varargs...)
+}
+
+// SendCommand mocks base method
+func (m *MockSSMClient) SendCommand(arg0 *ssm.SendCommandInput) (*ssm.SendCommandOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCommand", arg0)
+	ret0, _ := ret[0].(*ssm.SendCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCommand indicates an expected call of SendCommand
+func (mr *MockSSMClientMockRecorder) SendCommand(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCommand", reflect.TypeOf((*MockSSMClient)(nil).SendCommand), arg0)
+}
+
+// SendCommandRequest mocks base method
+func (m *MockSSMClient) SendCommandRequest(arg0 *ssm.SendCommandInput) (*request.Request, *ssm.SendCommandOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCommandRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.SendCommandOutput)
+	return ret0, ret1
+}
+
+// SendCommandRequest indicates an expected call of SendCommandRequest
+func (mr *MockSSMClientMockRecorder) SendCommandRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCommandRequest", reflect.TypeOf((*MockSSMClient)(nil).SendCommandRequest), arg0)
+}
+
+// SendCommandWithContext mocks base method
+func (m *MockSSMClient) SendCommandWithContext(arg0 context.Context, arg1 *ssm.SendCommandInput, arg2 ...request.Option) (*ssm.SendCommandOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendCommandWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.SendCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCommandWithContext indicates an expected call of SendCommandWithContext
+func (mr *MockSSMClientMockRecorder) SendCommandWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCommandWithContext", reflect.TypeOf((*MockSSMClient)(nil).SendCommandWithContext), varargs...)
+}
+
+// StartAssociationsOnce mocks base method
+func (m *MockSSMClient) StartAssociationsOnce(arg0 *ssm.StartAssociationsOnceInput) (*ssm.StartAssociationsOnceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartAssociationsOnce", arg0)
+	ret0, _ := ret[0].(*ssm.StartAssociationsOnceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartAssociationsOnce indicates an expected call of StartAssociationsOnce
+func (mr *MockSSMClientMockRecorder) StartAssociationsOnce(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAssociationsOnce", reflect.TypeOf((*MockSSMClient)(nil).StartAssociationsOnce), arg0)
+}
+
+// StartAssociationsOnceRequest mocks base method
+func (m *MockSSMClient) StartAssociationsOnceRequest(arg0 *ssm.StartAssociationsOnceInput) (*request.Request, *ssm.StartAssociationsOnceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartAssociationsOnceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.StartAssociationsOnceOutput)
+	return ret0, ret1
+}
+
+// StartAssociationsOnceRequest indicates an expected call of StartAssociationsOnceRequest
+func (mr *MockSSMClientMockRecorder) StartAssociationsOnceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAssociationsOnceRequest", reflect.TypeOf((*MockSSMClient)(nil).StartAssociationsOnceRequest), arg0)
+}
+
+// StartAssociationsOnceWithContext mocks base method
+func (m *MockSSMClient) StartAssociationsOnceWithContext(arg0 context.Context, arg1 *ssm.StartAssociationsOnceInput, arg2 ...request.Option) (*ssm.StartAssociationsOnceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartAssociationsOnceWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.StartAssociationsOnceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartAssociationsOnceWithContext indicates an expected call of StartAssociationsOnceWithContext
+func (mr *MockSSMClientMockRecorder) StartAssociationsOnceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAssociationsOnceWithContext", reflect.TypeOf((*MockSSMClient)(nil).StartAssociationsOnceWithContext), varargs...)
+}
+
+// StartAutomationExecution mocks base method
+func (m *MockSSMClient) StartAutomationExecution(arg0 *ssm.StartAutomationExecutionInput) (*ssm.StartAutomationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartAutomationExecution", arg0)
+	ret0, _ := ret[0].(*ssm.StartAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartAutomationExecution indicates an expected call of StartAutomationExecution
+func (mr *MockSSMClientMockRecorder) StartAutomationExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAutomationExecution", reflect.TypeOf((*MockSSMClient)(nil).StartAutomationExecution), arg0)
+}
+
+// StartAutomationExecutionRequest mocks base method
+func (m *MockSSMClient) StartAutomationExecutionRequest(arg0 *ssm.StartAutomationExecutionInput) (*request.Request, *ssm.StartAutomationExecutionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartAutomationExecutionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.StartAutomationExecutionOutput)
+	return ret0, ret1
+}
+
+// StartAutomationExecutionRequest indicates an expected call of StartAutomationExecutionRequest
+func (mr *MockSSMClientMockRecorder) StartAutomationExecutionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAutomationExecutionRequest", reflect.TypeOf((*MockSSMClient)(nil).StartAutomationExecutionRequest), arg0)
+}
+
+// StartAutomationExecutionWithContext mocks base method
+func (m *MockSSMClient) StartAutomationExecutionWithContext(arg0 context.Context, arg1 *ssm.StartAutomationExecutionInput, arg2 ...request.Option) (*ssm.StartAutomationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartAutomationExecutionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.StartAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartAutomationExecutionWithContext indicates an expected call of StartAutomationExecutionWithContext
+func (mr *MockSSMClientMockRecorder) StartAutomationExecutionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAutomationExecutionWithContext", reflect.TypeOf((*MockSSMClient)(nil).StartAutomationExecutionWithContext), varargs...)
+}
+
+// StartSession mocks base method
+func (m *MockSSMClient) StartSession(arg0 *ssm.StartSessionInput) (*ssm.StartSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", arg0)
+	ret0, _ := ret[0].(*ssm.StartSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSession indicates an expected call of StartSession
+func (mr *MockSSMClientMockRecorder) StartSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockSSMClient)(nil).StartSession), arg0)
+}
+
+// StartSessionRequest mocks base method
+func (m *MockSSMClient) StartSessionRequest(arg0 *ssm.StartSessionInput) (*request.Request, *ssm.StartSessionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSessionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.StartSessionOutput)
+	return ret0, ret1
+}
+
+// StartSessionRequest indicates an expected call of StartSessionRequest
+func (mr *MockSSMClientMockRecorder) StartSessionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSessionRequest", reflect.TypeOf((*MockSSMClient)(nil).StartSessionRequest), arg0)
+}
+
+// StartSessionWithContext mocks base method
+func (m *MockSSMClient) StartSessionWithContext(arg0 context.Context, arg1 *ssm.StartSessionInput, arg2 ...request.Option) (*ssm.StartSessionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartSessionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.StartSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSessionWithContext indicates an expected call of StartSessionWithContext
+func (mr *MockSSMClientMockRecorder) StartSessionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSessionWithContext", reflect.TypeOf((*MockSSMClient)(nil).StartSessionWithContext), varargs...)
+}
+
+// StopAutomationExecution mocks base method
+func (m *MockSSMClient) StopAutomationExecution(arg0 *ssm.StopAutomationExecutionInput) (*ssm.StopAutomationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopAutomationExecution", arg0)
+	ret0, _ := ret[0].(*ssm.StopAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopAutomationExecution indicates an expected call of StopAutomationExecution
+func (mr *MockSSMClientMockRecorder) StopAutomationExecution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopAutomationExecution", reflect.TypeOf((*MockSSMClient)(nil).StopAutomationExecution), arg0)
+}
+
+// StopAutomationExecutionRequest mocks base method
+func (m *MockSSMClient) StopAutomationExecutionRequest(arg0 *ssm.StopAutomationExecutionInput) (*request.Request, *ssm.StopAutomationExecutionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopAutomationExecutionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.StopAutomationExecutionOutput)
+	return ret0, ret1
+}
+
+// StopAutomationExecutionRequest indicates an expected call of StopAutomationExecutionRequest
+func (mr *MockSSMClientMockRecorder) StopAutomationExecutionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopAutomationExecutionRequest", reflect.TypeOf((*MockSSMClient)(nil).StopAutomationExecutionRequest), arg0)
+}
+
+// StopAutomationExecutionWithContext mocks base method
+func (m *MockSSMClient) StopAutomationExecutionWithContext(arg0 context.Context, arg1 *ssm.StopAutomationExecutionInput, arg2 ...request.Option) (*ssm.StopAutomationExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopAutomationExecutionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.StopAutomationExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopAutomationExecutionWithContext indicates an expected call of StopAutomationExecutionWithContext
+func (mr *MockSSMClientMockRecorder) StopAutomationExecutionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopAutomationExecutionWithContext", reflect.TypeOf((*MockSSMClient)(nil).StopAutomationExecutionWithContext), varargs...)
+}
+
+// TerminateSession mocks base method
+func (m *MockSSMClient) TerminateSession(arg0 *ssm.TerminateSessionInput) (*ssm.TerminateSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateSession", arg0)
+	ret0, _ := ret[0].(*ssm.TerminateSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TerminateSession indicates an expected call of TerminateSession
+func (mr *MockSSMClientMockRecorder) TerminateSession(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateSession", reflect.TypeOf((*MockSSMClient)(nil).TerminateSession), arg0)
+}
+
+// TerminateSessionRequest mocks base method
+func (m *MockSSMClient) TerminateSessionRequest(arg0 *ssm.TerminateSessionInput) (*request.Request, *ssm.TerminateSessionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateSessionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.TerminateSessionOutput)
+	return ret0, ret1
+}
+
+// TerminateSessionRequest indicates an expected call of TerminateSessionRequest
+func (mr *MockSSMClientMockRecorder) TerminateSessionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateSessionRequest", reflect.TypeOf((*MockSSMClient)(nil).TerminateSessionRequest), arg0)
+}
+
+// TerminateSessionWithContext mocks base method
+func (m *MockSSMClient) TerminateSessionWithContext(arg0 context.Context, arg1 *ssm.TerminateSessionInput, arg2 ...request.Option) (*ssm.TerminateSessionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TerminateSessionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.TerminateSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TerminateSessionWithContext indicates an expected call of TerminateSessionWithContext
+func (mr *MockSSMClientMockRecorder) TerminateSessionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateSessionWithContext", reflect.TypeOf((*MockSSMClient)(nil).TerminateSessionWithContext), varargs...)
+}
+
+// UpdateAssociation mocks base method
+func (m *MockSSMClient) UpdateAssociation(arg0 *ssm.UpdateAssociationInput) (*ssm.UpdateAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssociation", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssociation indicates an expected call of UpdateAssociation
+func (mr *MockSSMClientMockRecorder) UpdateAssociation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssociation", reflect.TypeOf((*MockSSMClient)(nil).UpdateAssociation), arg0)
+}
+
+// UpdateAssociationRequest mocks base method
+func (m *MockSSMClient) UpdateAssociationRequest(arg0 *ssm.UpdateAssociationInput) (*request.Request, *ssm.UpdateAssociationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssociationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateAssociationOutput)
+	return ret0, ret1
+}
+
+// UpdateAssociationRequest indicates an expected call of UpdateAssociationRequest
+func (mr *MockSSMClientMockRecorder) UpdateAssociationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssociationRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateAssociationRequest), arg0)
+}
+
+// UpdateAssociationStatus mocks base method
+func (m *MockSSMClient) UpdateAssociationStatus(arg0 *ssm.UpdateAssociationStatusInput) (*ssm.UpdateAssociationStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssociationStatus", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateAssociationStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssociationStatus indicates an expected call of UpdateAssociationStatus
+func (mr *MockSSMClientMockRecorder) UpdateAssociationStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssociationStatus", reflect.TypeOf((*MockSSMClient)(nil).UpdateAssociationStatus), arg0)
+}
+
+// UpdateAssociationStatusRequest mocks base method
+func (m *MockSSMClient) UpdateAssociationStatusRequest(arg0 *ssm.UpdateAssociationStatusInput) (*request.Request, *ssm.UpdateAssociationStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssociationStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateAssociationStatusOutput)
+	return ret0, ret1
+}
+
+// UpdateAssociationStatusRequest indicates an expected call of UpdateAssociationStatusRequest
+func (mr *MockSSMClientMockRecorder) UpdateAssociationStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssociationStatusRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateAssociationStatusRequest), arg0)
+}
+
+// UpdateAssociationStatusWithContext mocks base method
+func (m *MockSSMClient) UpdateAssociationStatusWithContext(arg0 context.Context, arg1 *ssm.UpdateAssociationStatusInput, arg2 ...request.Option) (*ssm.UpdateAssociationStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAssociationStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateAssociationStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssociationStatusWithContext indicates an expected call of UpdateAssociationStatusWithContext
+func (mr *MockSSMClientMockRecorder) UpdateAssociationStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssociationStatusWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateAssociationStatusWithContext), varargs...)
+}
+
+// UpdateAssociationWithContext mocks base method
+func (m *MockSSMClient) UpdateAssociationWithContext(arg0 context.Context, arg1 *ssm.UpdateAssociationInput, arg2 ...request.Option) (*ssm.UpdateAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAssociationWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAssociationWithContext indicates an expected call of UpdateAssociationWithContext
+func (mr *MockSSMClientMockRecorder) UpdateAssociationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssociationWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateAssociationWithContext), varargs...)
+}
+
+// UpdateDocument mocks base method
+func (m *MockSSMClient) UpdateDocument(arg0 *ssm.UpdateDocumentInput) (*ssm.UpdateDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDocument", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDocument indicates an expected call of UpdateDocument
+func (mr *MockSSMClientMockRecorder) UpdateDocument(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocument", reflect.TypeOf((*MockSSMClient)(nil).UpdateDocument), arg0)
+}
+
+// UpdateDocumentDefaultVersion mocks base method
+func (m *MockSSMClient) UpdateDocumentDefaultVersion(arg0 *ssm.UpdateDocumentDefaultVersionInput) (*ssm.UpdateDocumentDefaultVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDocumentDefaultVersion", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateDocumentDefaultVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDocumentDefaultVersion indicates an expected call of UpdateDocumentDefaultVersion
+func (mr *MockSSMClientMockRecorder) UpdateDocumentDefaultVersion(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocumentDefaultVersion", reflect.TypeOf((*MockSSMClient)(nil).UpdateDocumentDefaultVersion), arg0)
+}
+
+// UpdateDocumentDefaultVersionRequest mocks base method
+func (m *MockSSMClient) UpdateDocumentDefaultVersionRequest(arg0 *ssm.UpdateDocumentDefaultVersionInput) (*request.Request, *ssm.UpdateDocumentDefaultVersionOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDocumentDefaultVersionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateDocumentDefaultVersionOutput)
+	return ret0, ret1
+}
+
+// UpdateDocumentDefaultVersionRequest indicates an expected call of UpdateDocumentDefaultVersionRequest
+func (mr *MockSSMClientMockRecorder) UpdateDocumentDefaultVersionRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocumentDefaultVersionRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateDocumentDefaultVersionRequest), arg0)
+}
+
+// UpdateDocumentDefaultVersionWithContext mocks base method
+func (m *MockSSMClient) UpdateDocumentDefaultVersionWithContext(arg0 context.Context, arg1 *ssm.UpdateDocumentDefaultVersionInput, arg2 ...request.Option) (*ssm.UpdateDocumentDefaultVersionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDocumentDefaultVersionWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateDocumentDefaultVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDocumentDefaultVersionWithContext indicates an expected call of UpdateDocumentDefaultVersionWithContext
+func (mr *MockSSMClientMockRecorder) UpdateDocumentDefaultVersionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocumentDefaultVersionWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateDocumentDefaultVersionWithContext), varargs...)
+}
+
+// UpdateDocumentRequest mocks base method
+func (m *MockSSMClient) UpdateDocumentRequest(arg0 *ssm.UpdateDocumentInput) (*request.Request, *ssm.UpdateDocumentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDocumentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateDocumentOutput)
+	return ret0, ret1
+}
+
+// UpdateDocumentRequest indicates an expected call of UpdateDocumentRequest
+func (mr *MockSSMClientMockRecorder) UpdateDocumentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocumentRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateDocumentRequest), arg0)
+}
+
+// UpdateDocumentWithContext mocks base method
+func (m *MockSSMClient) UpdateDocumentWithContext(arg0 context.Context, arg1 *ssm.UpdateDocumentInput, arg2 ...request.Option) (*ssm.UpdateDocumentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDocumentWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateDocumentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDocumentWithContext indicates an expected call of UpdateDocumentWithContext
+func (mr *MockSSMClientMockRecorder) UpdateDocumentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDocumentWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateDocumentWithContext), varargs...)
+}
+
+// UpdateMaintenanceWindow mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindow(arg0 *ssm.UpdateMaintenanceWindowInput) (*ssm.UpdateMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindow", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindow indicates an expected call of UpdateMaintenanceWindow
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindow", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindow), arg0)
+}
+
+// UpdateMaintenanceWindowRequest mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowRequest(arg0 *ssm.UpdateMaintenanceWindowInput) (*request.Request, *ssm.UpdateMaintenanceWindowOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateMaintenanceWindowOutput)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowRequest indicates an expected call of UpdateMaintenanceWindowRequest
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowRequest), arg0)
+}
+
+// UpdateMaintenanceWindowTarget mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowTarget(arg0 *ssm.UpdateMaintenanceWindowTargetInput) (*ssm.UpdateMaintenanceWindowTargetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowTarget", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateMaintenanceWindowTargetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowTarget indicates an expected call of UpdateMaintenanceWindowTarget
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowTarget(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowTarget", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowTarget), arg0)
+}
+
+// UpdateMaintenanceWindowTargetRequest mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowTargetRequest(arg0 *ssm.UpdateMaintenanceWindowTargetInput) (*request.Request, *ssm.UpdateMaintenanceWindowTargetOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowTargetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateMaintenanceWindowTargetOutput)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowTargetRequest indicates an expected call of UpdateMaintenanceWindowTargetRequest
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowTargetRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowTargetRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowTargetRequest), arg0)
+}
+
+// UpdateMaintenanceWindowTargetWithContext mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowTargetWithContext(arg0 context.Context, arg1 *ssm.UpdateMaintenanceWindowTargetInput, arg2 ...request.Option) (*ssm.UpdateMaintenanceWindowTargetOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowTargetWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateMaintenanceWindowTargetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowTargetWithContext indicates an expected call of UpdateMaintenanceWindowTargetWithContext
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowTargetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowTargetWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowTargetWithContext), varargs...)
+}
+
+// UpdateMaintenanceWindowTask mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowTask(arg0 *ssm.UpdateMaintenanceWindowTaskInput) (*ssm.UpdateMaintenanceWindowTaskOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowTask", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateMaintenanceWindowTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowTask indicates an expected call of UpdateMaintenanceWindowTask
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowTask(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowTask", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowTask), arg0)
+}
+
+// UpdateMaintenanceWindowTaskRequest mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowTaskRequest(arg0 *ssm.UpdateMaintenanceWindowTaskInput) (*request.Request, *ssm.UpdateMaintenanceWindowTaskOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowTaskRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateMaintenanceWindowTaskOutput)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowTaskRequest indicates an expected call of UpdateMaintenanceWindowTaskRequest
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowTaskRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowTaskRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowTaskRequest), arg0)
+}
+
+// UpdateMaintenanceWindowTaskWithContext mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowTaskWithContext(arg0 context.Context, arg1 *ssm.UpdateMaintenanceWindowTaskInput, arg2 ...request.Option) (*ssm.UpdateMaintenanceWindowTaskOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowTaskWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateMaintenanceWindowTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowTaskWithContext indicates an expected call of UpdateMaintenanceWindowTaskWithContext
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowTaskWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowTaskWithContext), varargs...)
+}
+
+// UpdateMaintenanceWindowWithContext mocks base method
+func (m *MockSSMClient) UpdateMaintenanceWindowWithContext(arg0 context.Context, arg1 *ssm.UpdateMaintenanceWindowInput, arg2 ...request.Option) (*ssm.UpdateMaintenanceWindowOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMaintenanceWindowWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateMaintenanceWindowOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenanceWindowWithContext indicates an expected call of UpdateMaintenanceWindowWithContext
+func (mr *MockSSMClientMockRecorder) UpdateMaintenanceWindowWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenanceWindowWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateMaintenanceWindowWithContext), varargs...)
+}
+
+// UpdateManagedInstanceRole mocks base method
+func (m *MockSSMClient) UpdateManagedInstanceRole(arg0 *ssm.UpdateManagedInstanceRoleInput) (*ssm.UpdateManagedInstanceRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManagedInstanceRole", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateManagedInstanceRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManagedInstanceRole indicates an expected call of UpdateManagedInstanceRole
+func (mr *MockSSMClientMockRecorder) UpdateManagedInstanceRole(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManagedInstanceRole", reflect.TypeOf((*MockSSMClient)(nil).UpdateManagedInstanceRole), arg0)
+}
+
+// UpdateManagedInstanceRoleRequest mocks base method
+func (m *MockSSMClient) UpdateManagedInstanceRoleRequest(arg0 *ssm.UpdateManagedInstanceRoleInput) (*request.Request, *ssm.UpdateManagedInstanceRoleOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManagedInstanceRoleRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateManagedInstanceRoleOutput)
+	return ret0, ret1
+}
+
+// UpdateManagedInstanceRoleRequest indicates an expected call of UpdateManagedInstanceRoleRequest
+func (mr *MockSSMClientMockRecorder) UpdateManagedInstanceRoleRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManagedInstanceRoleRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateManagedInstanceRoleRequest), arg0)
+}
+
+// UpdateManagedInstanceRoleWithContext mocks base method
+func (m *MockSSMClient) UpdateManagedInstanceRoleWithContext(arg0 context.Context, arg1 *ssm.UpdateManagedInstanceRoleInput, arg2 ...request.Option) (*ssm.UpdateManagedInstanceRoleOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateManagedInstanceRoleWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateManagedInstanceRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManagedInstanceRoleWithContext indicates an expected call of UpdateManagedInstanceRoleWithContext
+func (mr *MockSSMClientMockRecorder) UpdateManagedInstanceRoleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManagedInstanceRoleWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateManagedInstanceRoleWithContext), varargs...)
+}
+
+// UpdateOpsItem mocks base method
+func (m *MockSSMClient) UpdateOpsItem(arg0 *ssm.UpdateOpsItemInput) (*ssm.UpdateOpsItemOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOpsItem", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateOpsItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOpsItem indicates an expected call of UpdateOpsItem
+func (mr *MockSSMClientMockRecorder) UpdateOpsItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOpsItem", reflect.TypeOf((*MockSSMClient)(nil).UpdateOpsItem), arg0)
+}
+
+// UpdateOpsItemRequest mocks base method
+func (m *MockSSMClient) UpdateOpsItemRequest(arg0 *ssm.UpdateOpsItemInput) (*request.Request, *ssm.UpdateOpsItemOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOpsItemRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateOpsItemOutput)
+	return ret0, ret1
+}
+
+// UpdateOpsItemRequest indicates an expected call of UpdateOpsItemRequest
+func (mr *MockSSMClientMockRecorder) UpdateOpsItemRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOpsItemRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateOpsItemRequest), arg0)
+}
+
+// UpdateOpsItemWithContext mocks base method
+func (m *MockSSMClient) UpdateOpsItemWithContext(arg0 context.Context, arg1 *ssm.UpdateOpsItemInput, arg2 ...request.Option) (*ssm.UpdateOpsItemOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateOpsItemWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateOpsItemOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOpsItemWithContext indicates an expected call of UpdateOpsItemWithContext
+func (mr *MockSSMClientMockRecorder) UpdateOpsItemWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOpsItemWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateOpsItemWithContext), varargs...)
+}
+
+// UpdatePatchBaseline mocks base method
+func (m *MockSSMClient) UpdatePatchBaseline(arg0 *ssm.UpdatePatchBaselineInput) (*ssm.UpdatePatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePatchBaseline", arg0)
+	ret0, _ := ret[0].(*ssm.UpdatePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePatchBaseline indicates an expected call of UpdatePatchBaseline
+func (mr *MockSSMClientMockRecorder) UpdatePatchBaseline(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatchBaseline", reflect.TypeOf((*MockSSMClient)(nil).UpdatePatchBaseline), arg0)
+}
+
+// UpdatePatchBaselineRequest mocks base method
+func (m *MockSSMClient) UpdatePatchBaselineRequest(arg0 *ssm.UpdatePatchBaselineInput) (*request.Request, *ssm.UpdatePatchBaselineOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePatchBaselineRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdatePatchBaselineOutput)
+	return ret0, ret1
+}
+
+// UpdatePatchBaselineRequest indicates an expected call of UpdatePatchBaselineRequest
+func (mr *MockSSMClientMockRecorder) UpdatePatchBaselineRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatchBaselineRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdatePatchBaselineRequest), arg0)
+}
+
+// UpdatePatchBaselineWithContext mocks base method
+func (m *MockSSMClient) UpdatePatchBaselineWithContext(arg0 context.Context, arg1 *ssm.UpdatePatchBaselineInput, arg2 ...request.Option) (*ssm.UpdatePatchBaselineOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePatchBaselineWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdatePatchBaselineOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePatchBaselineWithContext indicates an expected call of UpdatePatchBaselineWithContext
+func (mr *MockSSMClientMockRecorder) UpdatePatchBaselineWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatchBaselineWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdatePatchBaselineWithContext), varargs...)
+}
+
+// UpdateResourceDataSync mocks base method
+func (m *MockSSMClient) UpdateResourceDataSync(arg0 *ssm.UpdateResourceDataSyncInput) (*ssm.UpdateResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceDataSync", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceDataSync indicates an expected call of UpdateResourceDataSync
+func (mr *MockSSMClientMockRecorder) UpdateResourceDataSync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceDataSync", reflect.TypeOf((*MockSSMClient)(nil).UpdateResourceDataSync), arg0)
+}
+
+// UpdateResourceDataSyncRequest mocks base method
+func (m *MockSSMClient) UpdateResourceDataSyncRequest(arg0 *ssm.UpdateResourceDataSyncInput) (*request.Request, *ssm.UpdateResourceDataSyncOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceDataSyncRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateResourceDataSyncOutput)
+	return ret0, ret1
+}
+
+// UpdateResourceDataSyncRequest indicates an expected call of UpdateResourceDataSyncRequest
+func (mr *MockSSMClientMockRecorder) UpdateResourceDataSyncRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceDataSyncRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateResourceDataSyncRequest), arg0)
+}
+
+// UpdateResourceDataSyncWithContext mocks base method
+func (m *MockSSMClient) UpdateResourceDataSyncWithContext(arg0 context.Context, arg1 *ssm.UpdateResourceDataSyncInput, arg2 ...request.Option) (*ssm.UpdateResourceDataSyncOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateResourceDataSyncWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateResourceDataSyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceDataSyncWithContext indicates an expected call of UpdateResourceDataSyncWithContext
+func (mr *MockSSMClientMockRecorder) UpdateResourceDataSyncWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceDataSyncWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateResourceDataSyncWithContext), varargs...)
+}
+
+// UpdateServiceSetting mocks base method
+func (m *MockSSMClient) UpdateServiceSetting(arg0 *ssm.UpdateServiceSettingInput) (*ssm.UpdateServiceSettingOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceSetting", arg0)
+	ret0, _ := ret[0].(*ssm.UpdateServiceSettingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceSetting indicates an expected call of UpdateServiceSetting
+func (mr *MockSSMClientMockRecorder) UpdateServiceSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSetting", reflect.TypeOf((*MockSSMClient)(nil).UpdateServiceSetting), arg0)
+}
+
+// UpdateServiceSettingRequest mocks base method
+func (m *MockSSMClient) UpdateServiceSettingRequest(arg0 *ssm.UpdateServiceSettingInput) (*request.Request, *ssm.UpdateServiceSettingOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceSettingRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ssm.UpdateServiceSettingOutput)
+	return ret0, ret1
+}
+
+// UpdateServiceSettingRequest indicates an expected call of UpdateServiceSettingRequest
+func (mr *MockSSMClientMockRecorder) UpdateServiceSettingRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSettingRequest", reflect.TypeOf((*MockSSMClient)(nil).UpdateServiceSettingRequest), arg0)
+}
+
+// UpdateServiceSettingWithContext mocks base method
+func (m *MockSSMClient) UpdateServiceSettingWithContext(arg0 context.Context, arg1 *ssm.UpdateServiceSettingInput, arg2 ...request.Option) (*ssm.UpdateServiceSettingOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceSettingWithContext", varargs...)
+	ret0, _ := ret[0].(*ssm.UpdateServiceSettingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceSettingWithContext indicates an expected call of UpdateServiceSettingWithContext
+func (mr *MockSSMClientMockRecorder) UpdateServiceSettingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSettingWithContext", reflect.TypeOf((*MockSSMClient)(nil).UpdateServiceSettingWithContext), varargs...)
+}
+
+// WaitUntilCommandExecuted mocks base method
+func (m *MockSSMClient) WaitUntilCommandExecuted(arg0 *ssm.GetCommandInvocationInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilCommandExecuted", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilCommandExecuted indicates an expected call of WaitUntilCommandExecuted
+func (mr *MockSSMClientMockRecorder) WaitUntilCommandExecuted(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilCommandExecuted", reflect.TypeOf((*MockSSMClient)(nil).WaitUntilCommandExecuted), arg0)
+}
+
+// WaitUntilCommandExecutedWithContext mocks base method
+func (m *MockSSMClient) WaitUntilCommandExecutedWithContext(arg0 context.Context, arg1 *ssm.GetCommandInvocationInput, arg2 ...request.WaiterOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitUntilCommandExecutedWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilCommandExecutedWithContext indicates an expected call of WaitUntilCommandExecutedWithContext
+func (mr *MockSSMClientMockRecorder) WaitUntilCommandExecutedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilCommandExecutedWithContext", reflect.TypeOf((*MockSSMClient)(nil).WaitUntilCommandExecutedWithContext), varargs...)
+}
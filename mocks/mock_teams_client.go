@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/telia-oss/concourse-github-lambda (interfaces: TeamsClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	github "github.com/google/go-github/v29/github"
+	reflect "reflect"
+)
+
+// MockTeamsClient is a mock of TeamsClient interface
+type MockTeamsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamsClientMockRecorder
+}
+
+// MockTeamsClientMockRecorder is the mock recorder for MockTeamsClient
+type MockTeamsClientMockRecorder struct {
+	mock *MockTeamsClient
+}
+
+// NewMockTeamsClient creates a new mock instance
+func NewMockTeamsClient(ctrl *gomock.Controller) *MockTeamsClient {
+	mock := &MockTeamsClient{ctrl: ctrl}
+	mock.recorder = &MockTeamsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTeamsClient) EXPECT() *MockTeamsClientMockRecorder {
+	return m.recorder
+}
+
+// ListTeamReposBySlug mocks base method
+func (m *MockTeamsClient) ListTeamReposBySlug(arg0 context.Context, arg1, arg2 string, arg3 *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamReposBySlug", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*github.Repository)
+	ret1, _ := ret[1].(*github.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTeamReposBySlug indicates an expected call of ListTeamReposBySlug
+func (mr *MockTeamsClientMockRecorder) ListTeamReposBySlug(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamReposBySlug", reflect.TypeOf((*MockTeamsClient)(nil).ListTeamReposBySlug), arg0, arg1, arg2, arg3)
+}
@@ -1,21 +1,153 @@
 package handler
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
+
+	"github.com/google/go-github/v29/github"
 )
 
 // Team represents the configuration for a single CI/CD team.
 type Team struct {
-	Name         string       `json:"name"`
-	Repositories []Repository `json:"repositories"`
+	Name             string       `json:"name"`
+	KeyType          KeyType      `json:"keyType,omitempty"`
+	RotationInterval Duration     `json:"rotationInterval,omitempty"`
+	Repositories     []Repository `json:"repositories"`
+
+	// Permissions granted to the team's access tokens. Defaults to all the permissions of the token service app.
+	Permissions *github.InstallationPermissions `json:"permissions,omitempty"`
+
+	// DryRun can be set in the event payload to plan the changes for a single invocation.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Force can be set in the event payload to rotate all of the team's deploy keys and access tokens right away.
+	Force bool `json:"force,omitempty"`
+
+	// Revoke can be set in the event payload to revoke the team's (or an owner's) deploy keys, access tokens
+	// and secrets instead of rotating them.
+	Revoke *RevokeOptions `json:"revoke,omitempty"`
 }
 
-// Repository represents the configuration of a repository.
+// Repository represents the configuration of a repository. It can also be a selector which is resolved to
+// the matching repositories when the lambda runs (see IsSelector), in which case the rest of the settings
+// apply to each of them.
 type Repository struct {
-	Name     string `json:"name"`
-	Owner    string `json:"owner"`
-	ReadOnly bool   `json:"readOnly"`
+	Name             string   `json:"name"`
+	Owner            string   `json:"owner"`
+	ReadOnly         bool     `json:"readOnly"`
+	KeyType          KeyType  `json:"keyType,omitempty"`
+	RotationInterval Duration `json:"rotationInterval,omitempty"`
+
+	// Force can be set in the event payload to rotate the deploy key (and the owner's access token) right away.
+	Force bool `json:"force,omitempty"`
+
+	// Topics selects the repositories which have all of the topics.
+	Topics []string `json:"topics,omitempty"`
+
+	// GithubTeam selects the repositories a Github team (by slug) has access to on the owner.
+	GithubTeam string `json:"githubTeam,omitempty"`
+
+	// Exclude repositories matching any of the (glob) patterns from the selector.
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// IsSelector returns true if the repository is a selector, i.e. the name is a glob pattern (e.g. "payments-*")
+// or it selects repositories by topics or Github team.
+func (r Repository) IsSelector() bool {
+	return strings.ContainsAny(r.Name, "*?[") || len(r.Topics) > 0 || r.GithubTeam != ""
+}
+
+// KeyType of a deploy key.
+type KeyType string
+
+// Supported key types.
+const (
+	KeyTypeRSA2048   KeyType = "rsa-2048"
+	KeyTypeRSA4096   KeyType = "rsa-4096"
+	KeyTypeECDSAP256 KeyType = "ecdsa-p256"
+	KeyTypeEd25519   KeyType = "ed25519"
+)
+
+// DefaultKeyType is used when neither the repository nor the team specifies a key type.
+const DefaultKeyType = KeyTypeRSA2048
+
+// Validate the key type.
+func (t KeyType) Validate() error {
+	switch t {
+	case KeyTypeRSA2048, KeyTypeRSA4096, KeyTypeECDSAP256, KeyTypeEd25519:
+		return nil
+	}
+	return fmt.Errorf("unsupported key type: '%s'", t)
+}
+
+// KeyTypeFor returns the key type for a repository, falling back to the team default.
+func (t Team) KeyTypeFor(repository Repository) KeyType {
+	if repository.KeyType != "" {
+		return repository.KeyType
+	}
+	if t.KeyType != "" {
+		return t.KeyType
+	}
+	return DefaultKeyType
+}
+
+// ForceFor returns true if the deploy key for the repository should be rotated right away.
+func (t Team) ForceFor(repository Repository) bool {
+	return t.Force || repository.Force
+}
+
+// RotationIntervalFor returns the rotation interval for a repository, falling back to the
+// team default and lastly the provided default.
+func (t Team) RotationIntervalFor(repository Repository, defaultInterval time.Duration) time.Duration {
+	if repository.RotationInterval != 0 {
+		return time.Duration(repository.RotationInterval)
+	}
+	if t.RotationInterval != 0 {
+		return time.Duration(t.RotationInterval)
+	}
+	return defaultInterval
+}
+
+// Duration is a time.Duration which is (un)marshalled as a string, e.g. "12h" or "7d".
+type Duration time.Duration
+
+// ParseDuration parses a duration string. In addition to the units supported by
+// time.ParseDuration, it supports a "d" suffix for whole days (e.g. "7d").
+func ParseDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: '%s'", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %s", err)
+	}
+	v, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
 }
 
 // NewTemplate for github key title and secrets manager path.
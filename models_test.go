@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	handler "github.com/telia-oss/concourse-github-lambda"
 )
@@ -40,6 +41,37 @@ func TestConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "Unmarshal key types and rotation intervals",
+			input: strings.TrimSpace(`
+{
+    "name": "team",
+    "keyType": "ed25519",
+    "rotationInterval": "30d",
+    "repositories": [
+        {
+            "name": "repo1",
+            "owner": "telia-oss",
+            "keyType": "rsa-4096",
+            "rotationInterval": "24h"
+        }
+    ]
+}
+`),
+			expected: handler.Team{
+				Name:             "team",
+				KeyType:          handler.KeyTypeEd25519,
+				RotationInterval: handler.Duration(30 * 24 * time.Hour),
+				Repositories: []handler.Repository{
+					{
+						Name:             "repo1",
+						Owner:            "telia-oss",
+						KeyType:          handler.KeyTypeRSA4096,
+						RotationInterval: handler.Duration(24 * time.Hour),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -58,6 +90,71 @@ func TestConfig(t *testing.T) {
 	}
 }
 
+func TestKeyTypeFor(t *testing.T) {
+	tests := []struct {
+		description string
+		team        handler.Team
+		repository  handler.Repository
+		expected    handler.KeyType
+	}{
+		{
+			description: "defaults to rsa-2048",
+			expected:    handler.KeyTypeRSA2048,
+		},
+		{
+			description: "uses the team default",
+			team:        handler.Team{KeyType: handler.KeyTypeEd25519},
+			expected:    handler.KeyTypeEd25519,
+		},
+		{
+			description: "repository overrides the team default",
+			team:        handler.Team{KeyType: handler.KeyTypeEd25519},
+			repository:  handler.Repository{KeyType: handler.KeyTypeECDSAP256},
+			expected:    handler.KeyTypeECDSAP256,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got, want := tc.team.KeyTypeFor(tc.repository), tc.expected; got != want {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", got, want)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    time.Duration
+		shouldError bool
+	}{
+		{input: "12h", expected: 12 * time.Hour},
+		{input: "7d", expected: 7 * 24 * time.Hour},
+		{input: "1h30m", expected: 90 * time.Minute},
+		{input: "xd", shouldError: true},
+		{input: "7 days", shouldError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := handler.ParseDuration(tc.input)
+			if tc.shouldError {
+				if err == nil {
+					t.Fatal("expected an error to occur")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if want := tc.expected; got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
 func TestTemplate(t *testing.T) {
 	tests := []struct {
 		description string
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"github.com/google/go-github/v29/github"
+)
+
+// Number of results to request per page from the Github API (the maximum allowed).
+const perPage = 100
+
+// paginate calls list for each page of results until the response indicates there are no more pages.
+func paginate(list func(opts *github.ListOptions) (*github.Response, error)) error {
+	opts := &github.ListOptions{PerPage: perPage}
+	for {
+		res, err := list(opts)
+		if err != nil {
+			return err
+		}
+		if res == nil || res.NextPage == 0 {
+			return nil
+		}
+		opts.Page = res.NextPage
+	}
+}
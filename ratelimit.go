@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig configures how requests to Github are retried when they hit a rate limit or fail.
+type RateLimitConfig struct {
+	// Retries is the number of times a request is retried. Zero disables retries.
+	Retries int
+
+	// MaxWait is the longest to wait before retrying. Requests are not retried when Github asks
+	// to wait longer than this (e.g. when the rate limit resets in half an hour).
+	MaxWait time.Duration
+
+	// Backoff is the wait before retrying server errors, which doubles with each retry. Defaults to 1 second.
+	Backoff time.Duration
+
+	// MinRemaining is the number of requests that should be left for an installation. No new repositories are
+	// started for the owner when the remaining quota drops below it (until the rate limit resets).
+	MinRemaining int
+}
+
+func (c RateLimitConfig) backoff(attempt int) time.Duration {
+	backoff := c.Backoff
+	if backoff == 0 {
+		backoff = 1 * time.Second
+	}
+	return backoff << uint(attempt)
+}
+
+// RateLimit is the remaining quota for the installation of a Github App on an owner.
+type RateLimit struct {
+	App       string    `json:"app"`
+	Owner     string    `json:"owner"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+}
+
+// rateLimitQuota is updated from the rate limit headers of each response.
+type rateLimitQuota struct {
+	mu        sync.Mutex
+	seen      bool
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func (q *rateLimitQuota) update(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	reset, _ := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.seen, q.limit, q.remaining, q.reset = true, limit, remaining, time.Unix(reset, 0).UTC()
+}
+
+func (q *rateLimitQuota) get() (limit, remaining int, reset time.Time, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.limit, q.remaining, q.reset, q.seen
+}
+
+// rateLimitTransport retries requests which hit a rate limit (honouring Retry-After and X-RateLimit-Reset),
+// and idempotent requests which fail with a server or network error. The quota is optional.
+type rateLimitTransport struct {
+	base   http.RoundTripper
+	config RateLimitConfig
+	quota  *rateLimitQuota
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to retry request: %s", err)
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		res, err := t.base.RoundTrip(r)
+		if res != nil && t.quota != nil {
+			t.quota.update(res.Header)
+		}
+		if attempt >= t.config.Retries || req.Context().Err() != nil || (req.Body != nil && req.GetBody == nil) {
+			return res, err
+		}
+		wait, retry := t.retryAfter(req, res, attempt)
+		if !retry || wait > t.config.MaxWait {
+			return res, err
+		}
+		if res != nil {
+			io.Copy(ioutil.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Returns how long to wait before retrying, and false if the request should not be retried.
+func (t *rateLimitTransport) retryAfter(req *http.Request, res *http.Response, attempt int) (time.Duration, bool) {
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodDelete
+	if res == nil {
+		return t.config.backoff(attempt), idempotent
+	}
+
+	switch res.StatusCode {
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		// Secondary rate limits ask for a wait in seconds, and the primary rate limit resets at a point in time.
+		// Forbidden responses without either are permission errors.
+		if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if res.Header.Get("X-RateLimit-Remaining") == "0" {
+			reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64)
+			if err != nil {
+				return 0, false
+			}
+			wait := time.Until(time.Unix(reset, 0))
+			if wait < 0 {
+				wait = 0
+			}
+			return wait, true
+		}
+		return t.config.backoff(attempt), res.StatusCode == http.StatusTooManyRequests
+
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return t.config.backoff(attempt), idempotent
+	}
+	return 0, false
+}
+
+// Returns the quota for an owner's installation.
+func (a *GithubApp) quota(owner string) *rateLimitQuota {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.quotas == nil {
+		a.quotas = make(map[string]*rateLimitQuota)
+	}
+	q, ok := a.quotas[owner]
+	if !ok {
+		q = &rateLimitQuota{}
+		a.quotas[owner] = q
+	}
+	return q
+}
+
+// Transport returns a transport for requests to an owner's installation, which retries according to the
+// rate limit config and records the remaining quota of the installation.
+func (a *GithubApp) Transport(owner string, base http.RoundTripper) http.RoundTripper {
+	return &rateLimitTransport{base: base, config: a.RateLimit, quota: a.quota(strings.ToLower(owner))}
+}
+
+// Returns the remaining quota for each installation the app has made requests to.
+func (a *GithubApp) rateLimits() []RateLimit {
+	a.mu.RLock()
+	owners := make([]string, 0, len(a.quotas))
+	for owner := range a.quotas {
+		owners = append(owners, owner)
+	}
+	a.mu.RUnlock()
+	sort.Strings(owners)
+
+	var limits []RateLimit
+	for _, owner := range owners {
+		limit, remaining, reset, ok := a.quota(owner).get()
+		if !ok {
+			continue
+		}
+		limits = append(limits, RateLimit{App: a.Name, Owner: owner, Limit: limit, Remaining: remaining, Reset: reset})
+	}
+	return limits
+}
+
+// Returns an error if the remaining quota for the owner's installation is below the minimum.
+func (a *GithubApp) checkRateLimit(owner string) error {
+	if a.RateLimit.MinRemaining == 0 {
+		return nil
+	}
+	limit, remaining, reset, ok := a.quota(strings.ToLower(owner)).get()
+	if ok && remaining < a.RateLimit.MinRemaining && time.Now().Before(reset) {
+		return fmt.Errorf("only %d of %d requests remaining for the %s installation on '%s' until %s",
+			remaining, limit, a.Name, owner, reset.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+// rateLimitedGithub adds rate limit headers to the responses of the fake, and fails the first
+// request matching each of the configured failures.
+type rateLimitedGithub struct {
+	*fakeGithub
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+	failures  map[string]func(w http.ResponseWriter)
+}
+
+func (g *rateLimitedGithub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	g.remaining--
+	w.Header().Set("X-RateLimit-Limit", "5000")
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(g.remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(g.reset.Unix(), 10))
+	fail, ok := g.failures[r.Method+" "+r.URL.Path]
+	delete(g.failures, r.Method+" "+r.URL.Path)
+	g.mu.Unlock()
+
+	if ok {
+		fail(w)
+		return
+	}
+	g.fakeGithub.ServeHTTP(w, r)
+}
+
+func TestHandlerRateLimits(t *testing.T) {
+	secondaryRateLimit := func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusForbidden)
+	}
+	tooManyRequests := func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+	badGateway := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusBadGateway)
+	}
+	primaryRateLimit := func(w http.ResponseWriter) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusForbidden)
+	}
+
+	tests := []struct {
+		description      string
+		remaining        int
+		failures         map[string]func(w http.ResponseWriter)
+		exhausted        bool
+		expectedStatuses []handler.Status
+	}{
+		{
+			description: "retries rate limited requests and server errors",
+			remaining:   5000,
+			failures: map[string]func(w http.ResponseWriter){
+				"GET /repos/telia-oss/first-repository":        badGateway,
+				"GET /repos/telia-oss/first-repository/keys":   secondaryRateLimit,
+				"POST /repos/telia-oss/second-repository/keys": tooManyRequests,
+				"GET /repos/telia-oss/second-repository/keys":  badGateway,
+				"POST /app/installations/1/access_tokens":      secondaryRateLimit,
+				"GET /repos/telia-oss/second-repository":       secondaryRateLimit,
+			},
+			expectedStatuses: []handler.Status{handler.StatusCreated, handler.StatusCreated},
+		},
+		{
+			description: "does not wait for the rate limit to reset",
+			remaining:   5000,
+			failures: map[string]func(w http.ResponseWriter){
+				"GET /repos/telia-oss/first-repository/keys": primaryRateLimit,
+			},
+			exhausted:        true,
+			expectedStatuses: []handler.Status{handler.StatusFailed, handler.StatusDeferred},
+		},
+		{
+			description:      "defers repositories when running low on requests",
+			remaining:        100,
+			expectedStatuses: []handler.Status{handler.StatusCreated, handler.StatusDeferred},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			fake := &rateLimitedGithub{
+				fakeGithub: newFakeGithub(100),
+				remaining:  tc.remaining,
+				reset:      time.Now().Add(1 * time.Hour),
+				failures:   tc.failures,
+			}
+			fake.repositories["telia-oss/first-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("first-repository")}
+			fake.repositories["telia-oss/second-repository"] = &github.Repository{ID: github.Int64(2), Name: github.String("second-repository")}
+
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			vault := httptest.NewServer(newFakeVault("token", ""))
+			defer vault.Close()
+
+			store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: vault.URL, Token: "token"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			services := &handler.GithubApp{
+				Name:          "key-service",
+				Installations: map[string]int64{"telia-oss": 1},
+				RateLimit: handler.RateLimitConfig{
+					Retries:      2,
+					MaxWait:      1 * time.Second,
+					Backoff:      1 * time.Millisecond,
+					MinRemaining: 100,
+				},
+			}
+			client := github.NewClient(&http.Client{Transport: services.Transport("telia-oss", http.DefaultTransport)})
+			client.BaseURL, _ = url.Parse(server.URL + "/")
+			services.App = client.Apps
+			services.Clients = map[string]*handler.GithubClient{
+				"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+			}
+
+			manager := handler.NewTestManager(store, handler.NewLocalKeyGenerator(), services, services, nil, nil)
+			logger, _ := logrus.NewNullLogger()
+			handle := handler.New(manager, handler.Config{
+				TokenPath: "/concourse/{{.Team}}/{{.Owner}}-access-token",
+				KeyPath:   "/concourse/{{.Team}}/{{.Repository}}-deploy-key",
+				KeyTitle:  "concourse-{{.Team}}-deploy-key",
+				Workers:   1,
+				Lenient:   true,
+			}, logger)
+
+			team := handler.Team{
+				Name: "test-team",
+				Repositories: []handler.Repository{
+					{Name: "first-repository", Owner: "telia-oss", ReadOnly: true},
+					{Name: "second-repository", Owner: "telia-oss", ReadOnly: true},
+				},
+			}
+			result, err := handle(context.Background(), team)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			for i, r := range result.Repositories {
+				if got, want := r.Status, tc.expectedStatuses[i]; got != want {
+					t.Errorf("got status %s for %s, want %s: %s", got, r.Name, want, r.Reason)
+				}
+			}
+
+			if len(result.RateLimits) != 1 {
+				t.Fatalf("expected one rate limit, got: %v", result.RateLimits)
+			}
+			limit := result.RateLimits[0]
+			if limit.App != "key-service" || limit.Owner != "telia-oss" || limit.Limit != 5000 {
+				t.Errorf("unexpected rate limit: %+v", limit)
+			}
+			fake.mu.Lock()
+			remaining, failures := fake.remaining, len(fake.failures)
+			fake.mu.Unlock()
+			if failures != 0 {
+				t.Errorf("expected all failures to be requested, %d remaining", failures)
+			}
+			if tc.exhausted {
+				remaining = 0
+			}
+			if got, want := limit.Remaining, remaining; got != want {
+				t.Errorf("got %d remaining requests, want %d", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	"github.com/sirupsen/logrus"
+)
+
+// Labels used to record which team, owner and repository a secret was written for.
+const (
+	labelTeam       = "concourse-github-lambda/team"
+	labelOwner      = "concourse-github-lambda/owner"
+	labelRepository = "concourse-github-lambda/repository"
+	labelRemovedAt  = "concourse-github-lambda/removed-at"
+)
+
+// Labels for a secret. The repository is empty for access tokens.
+func secretLabels(team, owner, repository string) map[string]string {
+	labels := map[string]string{
+		labelTeam:  team,
+		labelOwner: owner,
+	}
+	if repository != "" {
+		labels[labelRepository] = repository
+	}
+	return labels
+}
+
+// Placeholder used to find the static prefix of a path template.
+const templatePlaceholder = "\x00"
+
+// The part of a secret path template which is the same for all of a team's repositories.
+func secretPrefix(team, pathTemplate string) (string, error) {
+	path, err := NewTemplate(team, templatePlaceholder, templatePlaceholder, pathTemplate).String()
+	if err != nil {
+		return "", err
+	}
+	if i := strings.Index(path, templatePlaceholder); i >= 0 {
+		path = path[:i]
+	}
+	return path, nil
+}
+
+// Find secrets written for repositories (or owners) which are no longer in the team's config, and
+// delete them along with their deploy keys once the removal delay has passed. The time a removal was
+// first noticed is recorded as a label on the secret, and removed again if the repository is re-added.
+func reconcile(ctx context.Context, manager *Manager, config Config, team Team, result *Result, log *logrus.Entry) error {
+	// Secrets (and their labels) expected for the current config
+	expected := make(map[string]map[string]string)
+	for _, repository := range team.Repositories {
+		tokenPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.TokenPath).String()
+		if err != nil {
+			return fmt.Errorf("failed to parse token path template: %s", err)
+		}
+		keyPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.KeyPath).String()
+		if err != nil {
+			return fmt.Errorf("failed to parse deploy key template: %s", err)
+		}
+		expected[tokenPath] = secretLabels(team.Name, repository.Owner, "")
+		expected[keyPath] = secretLabels(team.Name, repository.Owner, repository.Name)
+	}
+
+	var secrets []*SecretMetadata
+	seen := make(map[string]bool)
+	for _, pathTemplate := range []string{config.TokenPath, config.KeyPath} {
+		prefix, err := secretPrefix(team.Name, pathTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse path template: %s", err)
+		}
+		if seen[prefix] {
+			continue
+		}
+		seen[prefix] = true
+
+		list, err := manager.listSecrets(ctx, prefix)
+		if err != nil {
+			return fmt.Errorf("failed to list secrets: %s", err)
+		}
+		secrets = append(secrets, list...)
+	}
+
+	listed := make(map[string]bool)
+	for _, secret := range secrets {
+		if listed[secret.Name] {
+			continue
+		}
+		listed[secret.Name] = true
+
+		// Label secrets that are still in use, e.g. if they were written before labels were added or the
+		// repository has been re-added to the config.
+		if labels, ok := expected[secret.Name]; ok {
+			update := make(map[string]string)
+			for k, v := range labels {
+				if secret.Labels[k] != v {
+					update[k] = v
+				}
+			}
+			if secret.Labels[labelRemovedAt] != "" {
+				update[labelRemovedAt] = ""
+			}
+			if len(update) > 0 && !result.DryRun {
+				if err := manager.setSecretLabels(ctx, secret.Name, update); err != nil {
+					log.Warnf("failed to label secret: %s: %s", secret.Name, err)
+				}
+			}
+			continue
+		}
+
+		// Secrets which do not belong to the team are left alone.
+		if secret.Labels[labelTeam] != team.Name {
+			continue
+		}
+
+		r := &RepositoryResult{
+			Name:  secret.Labels[labelRepository],
+			Owner: secret.Labels[labelOwner],
+		}
+		result.Removed = append(result.Removed, r)
+
+		status, err := removeSecret(ctx, manager, config, team, secret, result.DryRun, r)
+		r.Status = status
+		if err != nil {
+			log.WithFields(logrus.Fields{"owner": r.Owner, "repository": r.Name}).Warn(err)
+			r.Status, r.Reason = StatusFailed, err.Error()
+		}
+	}
+	return nil
+}
+
+// Record when the removal was noticed, or delete the secret (and the deploy keys for the repository) once the delay has passed.
+func removeSecret(
+	ctx context.Context,
+	manager *Manager,
+	config Config,
+	team Team,
+	secret *SecretMetadata,
+	dryRun bool,
+	result *RepositoryResult,
+) (Status, error) {
+	if _, ok := secret.Labels[labelRemovedAt]; !ok {
+		if dryRun {
+			return StatusPendingRemoval, nil
+		}
+		err := manager.setSecretLabels(ctx, secret.Name, map[string]string{
+			labelRemovedAt: time.Now().UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return StatusFailed, fmt.Errorf("failed to label secret as removed: %s", err)
+		}
+		return StatusPendingRemoval, nil
+	}
+
+	removedAt, err := time.Parse(time.RFC3339, secret.Labels[labelRemovedAt])
+	if err != nil {
+		return StatusFailed, fmt.Errorf("failed to parse removal timestamp: %s", err)
+	}
+	if time.Since(removedAt) < config.RemovalDelay {
+		return StatusPendingRemoval, nil
+	}
+
+	// Delete the deploy keys before the secret, so that the removal is retried if it fails.
+	if result.Name != "" {
+		repository := Repository{Name: result.Name, Owner: result.Owner}
+		if err := deleteTeamKeys(ctx, manager, config, team, repository, dryRun, result); err != nil {
+			return StatusFailed, err
+		}
+	}
+
+	if !dryRun {
+		if err := manager.deleteSecret(ctx, secret.Name); err != nil && !errors.Is(err, ErrSecretNotFound) {
+			return StatusFailed, fmt.Errorf("failed to delete secret: %s", err)
+		}
+	}
+	result.addAction(ActionDeleteSecret, secret.Name)
+	return StatusRemoved, nil
+}
+
+// Delete the team's deploy keys (i.e. those matching the title template) and the rotation state for a repository.
+func deleteTeamKeys(
+	ctx context.Context,
+	manager *Manager,
+	config Config,
+	team Team,
+	repository Repository,
+	dryRun bool,
+	result *RepositoryResult,
+) error {
+	title, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.KeyTitle).String()
+	if err != nil {
+		return fmt.Errorf("failed to github title template: %s", err)
+	}
+
+	keys, err := manager.listKeys(ctx, repository)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to list github keys: %s", err)
+	}
+	var matching []*github.Key
+	for _, key := range keys {
+		if key.GetTitle() == title {
+			matching = append(matching, key)
+		}
+	}
+	if err := deleteKeys(ctx, manager, repository, matching, dryRun, result); err != nil {
+		return err
+	}
+	if !dryRun {
+		if err := manager.deleteState(ctx, team.Name, repository); err != nil {
+			return fmt.Errorf("failed to delete rotation state: %s", err)
+		}
+	}
+	return nil
+}
+
+// Returns true if the error is a not found response from Github (e.g. because the repository has been deleted).
+func isNotFound(err error) bool {
+	e, ok := err.(*github.ErrorResponse)
+	return ok && e.Response != nil && e.Response.StatusCode == http.StatusNotFound
+}
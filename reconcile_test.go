@@ -0,0 +1,135 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+func TestHandlerReconcile(t *testing.T) {
+	fake := newFakeGithub(100)
+	fake.repositories["telia-oss/kept-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("kept-repository")}
+	fake.repositories["telia-oss/removed-repository"] = &github.Repository{ID: github.Int64(2), Name: github.String("removed-repository")}
+	fake.keys["telia-oss/removed-repository"] = []*github.Key{
+		{ID: github.Int64(1), Title: github.String("some-other-key"), ReadOnly: github.Bool(true)},
+	}
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	vault := newFakeVault("token", "")
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Secrets which were not written by the lambda are left alone
+	if err := store.WriteSecret(context.Background(), "/concourse/test-team/manual-secret", "secret", nil); err != nil {
+		t.Fatalf("failed to write secret: %s", err)
+	}
+
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+	}
+	state := handler.NewMemoryStateStore()
+	manager := handler.NewTestManager(store, handler.NewLocalKeyGenerator(), services, services, state, nil)
+	logger, _ := logrus.NewNullLogger()
+	handle := handler.New(manager, handler.Config{
+		TokenPath:    "/concourse/{{.Team}}/{{.Owner}}-access-token",
+		KeyPath:      "/concourse/{{.Team}}/{{.Repository}}-deploy-key",
+		KeyTitle:     "concourse-{{.Team}}-deploy-key",
+		Reconcile:    true,
+		RemovalDelay: 1 * time.Hour,
+	}, logger)
+
+	kept := handler.Repository{Name: "kept-repository", Owner: "telia-oss"}
+	removed := handler.Repository{Name: "removed-repository", Owner: "telia-oss"}
+
+	// Create keys and secrets for both repositories
+	result, err := handle(context.Background(), handler.Team{Name: "test-team", Repositories: []handler.Repository{kept, removed}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(result.Removed) != 0 {
+		t.Fatalf("unexpected removed repositories: %v", result.Removed)
+	}
+
+	// Removing a repository marks it for removal
+	team := handler.Team{Name: "test-team", Repositories: []handler.Repository{kept}}
+	result, err = handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(result.Removed) != 1 || result.Removed[0].Status != handler.StatusPendingRemoval {
+		t.Fatalf("expected removed-repository to be pending removal, got: %v", result.Removed)
+	}
+	metadata := vault.metadata["concourse/test-team/removed-repository-deploy-key"]
+	removedAt, ok := metadata["concourse-github-lambda/removed-at"]
+	if !ok {
+		t.Fatalf("expected the removal time to be recorded: %v", metadata)
+	}
+
+	// Nothing is deleted before the delay has passed
+	result, err = handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := result.Removed[0].Status, handler.StatusPendingRemoval; got != want {
+		t.Errorf("got status %s, want %s", got, want)
+	}
+	if got := vault.metadata["concourse/test-team/removed-repository-deploy-key"]["concourse-github-lambda/removed-at"]; got != removedAt {
+		t.Errorf("expected the removal time to be unchanged, got %s want %s", got, removedAt)
+	}
+
+	// Deploy key and secret are deleted once the delay has passed
+	metadata["concourse-github-lambda/removed-at"] = time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	result, err = handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(result.Removed) != 1 || result.Removed[0].Status != handler.StatusRemoved {
+		t.Fatalf("expected removed-repository to be removed, got: %v", result.Removed)
+	}
+	if _, ok := vault.secrets["concourse/test-team/removed-repository-deploy-key"]; ok {
+		t.Error("expected the secret to be deleted")
+	}
+	if keys := fake.keys["telia-oss/removed-repository"]; len(keys) != 1 || keys[0].GetTitle() != "some-other-key" {
+		t.Errorf("expected only the team's deploy key to be deleted, got: %v", keys)
+	}
+	if _, err := state.GetState(context.Background(), "test-team", "telia-oss", "removed-repository"); !errors.Is(err, handler.ErrStateNotFound) {
+		t.Errorf("expected the rotation state to be deleted, got: %v", err)
+	}
+	if _, err := state.GetState(context.Background(), "test-team", "telia-oss", "kept-repository"); err != nil {
+		t.Errorf("expected the rotation state to be kept, got: %s", err)
+	}
+	for _, name := range []string{
+		"concourse/test-team/kept-repository-deploy-key",
+		"concourse/test-team/telia-oss-access-token",
+		"concourse/test-team/manual-secret",
+	} {
+		if _, ok := vault.secrets[name]; !ok {
+			t.Errorf("expected secret to be kept: %s", name)
+		}
+	}
+
+	// Removed repositories are not reported once they have been cleaned up
+	result, err = handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(result.Removed) != 0 {
+		t.Errorf("unexpected removed repositories: %v", result.Removed)
+	}
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v29/github"
+)
+
+// Status of a repository after the handler has processed it.
+type Status string
+
+// Possible statuses for a repository.
+const (
+	StatusCreated      Status = "created"
+	StatusRotated      Status = "rotated"
+	StatusSkippedFresh Status = "skipped-fresh"
+	StatusFailed       Status = "failed"
+	StatusDeferred     Status = "deferred"
+
+	// Statuses for repositories which are skipped since they cannot have deploy keys, or have moved.
+	StatusSkippedArchived Status = "skipped-archived"
+	StatusSkippedDisabled Status = "skipped-disabled"
+	StatusNotFound        Status = "not-found"
+	StatusRenamed         Status = "renamed"
+
+	// Statuses for repositories which have been removed from the team's config.
+	StatusPendingRemoval Status = "pending-removal"
+	StatusRemoved        Status = "removed"
+
+	// Status for repositories where the team's deploy keys and secrets have been revoked.
+	StatusRevoked Status = "revoked"
+)
+
+// ActionType describes a change to Github or the secret store.
+type ActionType string
+
+// Possible action types.
+const (
+	ActionWriteSecret ActionType = "write-secret"
+	ActionCreateKey   ActionType = "create-key"
+	ActionDeleteKey   ActionType = "delete-key"
+	ActionRevokeToken ActionType = "revoke-token"
+
+	ActionDeleteSecret ActionType = "delete-secret"
+)
+
+// Action made by the handler, or planned when running in dry-run mode.
+type Action struct {
+	Type   ActionType `json:"type"`
+	Target string     `json:"target"`
+}
+
+// Result of running the handler for a team. When DryRun is set, the result is a
+// plan and none of the actions have been carried out.
+type Result struct {
+	Team         string              `json:"team"`
+	DryRun       bool                `json:"dryRun,omitempty"`
+	Repositories []*RepositoryResult `json:"repositories"`
+
+	// Removed lists the repositories that have been removed from the team's config, and
+	// are pending removal or have been cleaned up (only set when reconciling).
+	Removed []*RepositoryResult `json:"removed,omitempty"`
+
+	// RateLimits is the remaining quota of the Github App installations used for the team.
+	RateLimits []RateLimit `json:"rateLimits,omitempty"`
+}
+
+// RepositoryResult describes what happened to a single repository.
+type RepositoryResult struct {
+	// Team is only set when the result covers several teams (i.e. when revoking all teams for an owner).
+	Team string `json:"team,omitempty"`
+
+	Name    string   `json:"name"`
+	Owner   string   `json:"owner"`
+	Status  Status   `json:"status"`
+	Reason  string   `json:"reason,omitempty"`
+	Actions []Action `json:"actions,omitempty"`
+
+	// RenamedTo is the new name (owner/name) of a repository which has been renamed or transferred.
+	RenamedTo string `json:"renamedTo,omitempty"`
+
+	// PendingKeys are the IDs of superseded deploy keys that will be deleted once the overlap has passed.
+	PendingKeys []int64 `json:"pendingKeys,omitempty"`
+}
+
+func (r *RepositoryResult) addAction(t ActionType, target string) {
+	r.Actions = append(r.Actions, Action{Type: t, Target: target})
+}
+
+func (r *RepositoryResult) setPendingKeys(keys []*github.Key) {
+	r.PendingKeys = nil
+	for _, key := range keys {
+		r.PendingKeys = append(r.PendingKeys, key.GetID())
+	}
+}
+
+// Failed returns the repositories (including removed repositories) which failed.
+func (r *Result) Failed() []*RepositoryResult {
+	var failed []*RepositoryResult
+	for _, repositories := range [][]*RepositoryResult{r.Repositories, r.Removed} {
+		for _, repository := range repositories {
+			if repository.Status == StatusFailed {
+				failed = append(failed, repository)
+			}
+		}
+	}
+	return failed
+}
+
+// Deferred returns the repositories which were not processed because the handler ran out of time.
+func (r *Result) Deferred() []*RepositoryResult {
+	var deferred []*RepositoryResult
+	for _, repository := range r.Repositories {
+		if repository.Status == StatusDeferred {
+			deferred = append(deferred, repository)
+		}
+	}
+	return deferred
+}
+
+// Err returns an aggregated error if any of the repositories failed.
+func (r *Result) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	reasons := make([]string, len(failed))
+	for i, repository := range failed {
+		reasons[i] = fmt.Sprintf("%s/%s: %s", repository.Owner, repository.Name, repository.Reason)
+	}
+	return fmt.Errorf("failed to process %d of %d repositories: %s", len(failed), len(r.Repositories)+len(r.Removed), strings.Join(reasons, "; "))
+}
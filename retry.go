@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Retry an operation with exponential backoff for as long as it fails with a transient error,
+// up to config.Retries times.
+func retry(ctx context.Context, config Config, op func() error) error {
+	backoff := config.RetryBackoff
+	if backoff == 0 {
+		backoff = 1 * time.Second
+	}
+	for attempt := 0; ; attempt++ {
+		err := op()
+		if err == nil || attempt >= config.Retries || !isTransient(err) {
+			return err
+		}
+		timer := time.NewTimer(backoff << uint(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// Returns true for errors which are likely to go away if the call is retried, i.e. throttling,
+// server errors and network errors from the secret stores. Github requests are retried by the
+// rate limited transport instead, so they are not retried again here.
+func isTransient(err error) bool {
+	var (
+		requestFailure awserr.RequestFailure
+		awsError       awserr.Error
+		vaultErr       *vaultError
+		netErr         net.Error
+	)
+	switch {
+	case errors.As(err, &requestFailure):
+		if code := requestFailure.StatusCode(); code >= 500 || code == http.StatusTooManyRequests {
+			return true
+		}
+		return isTransientCode(requestFailure.Code())
+	case errors.As(err, &awsError):
+		return isTransientCode(awsError.Code())
+	case errors.As(err, &vaultErr):
+		return vaultErr.StatusCode >= 500 || vaultErr.StatusCode == http.StatusTooManyRequests
+	case errors.As(err, &netErr):
+		return netErr.Timeout() || netErr.Temporary()
+	}
+	return false
+}
+
+func isTransientCode(code string) bool {
+	switch code {
+	case "Throttling", "ThrottlingException", "TooManyRequestsException", "RequestLimitExceeded",
+		"InternalFailure", "InternalServiceError", "InternalServerError", "ServiceUnavailable",
+		"RequestTimeout", "RequestTimeoutException", request.ErrCodeRequestError, request.ErrCodeResponseTimeout:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,257 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+// unavailableVault fails the given number of writes of the given kind ("data" or "metadata") to
+// secret paths containing match, after letting the first (after) of them through.
+type unavailableVault struct {
+	*fakeVault
+	kind     string
+	match    string
+	after    int
+	failures int
+}
+
+func (v *unavailableVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	fail := v.failures > 0 && r.Method == http.MethodPost && strings.Contains(r.URL.Path, "/"+v.kind+"/") && strings.Contains(r.URL.Path, v.match)
+	if fail && v.after > 0 {
+		fail, v.after = false, v.after-1
+	}
+	if fail {
+		v.failures--
+	}
+	v.mu.Unlock()
+
+	if fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"errors":["service unavailable"]}`))
+		return
+	}
+	v.fakeVault.ServeHTTP(w, r)
+}
+
+// unavailableGithub fails all requests to delete deploy keys with a server error.
+type unavailableGithub struct {
+	*fakeGithub
+}
+
+func (g *unavailableGithub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodDelete && strings.Contains(r.URL.Path, "/keys/") {
+		g.mu.Lock()
+		g.requests = append(g.requests, r.Method+" "+r.URL.Path)
+		g.mu.Unlock()
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	g.fakeGithub.ServeHTTP(w, r)
+}
+
+// Start the fakes and return a handler which retries writes to the secret store twice.
+func newRetryTestHandler(t *testing.T, gh http.Handler, vault http.Handler) (func(context.Context, handler.Team) (*handler.Result, error), func()) {
+	t.Helper()
+	githubServer := httptest.NewServer(gh)
+	client := github.NewClient(githubServer.Client())
+	client.BaseURL, _ = url.Parse(githubServer.URL + "/")
+	server := httptest.NewServer(vault)
+
+	store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+		TokenClient: func(owner, token string) (handler.AppsClient, error) {
+			return client.Apps, nil
+		},
+	}
+	manager := handler.NewTestManager(store, handler.NewLocalKeyGenerator(), services, services, nil, nil)
+	logger, _ := logrus.NewNullLogger()
+	handle := handler.New(manager, handler.Config{
+		TokenPath:        "/concourse/{{.Team}}/{{.Owner}}-access-token",
+		KeyPath:          "/concourse/{{.Team}}/{{.Repository}}-deploy-key",
+		KeyTitle:         "concourse-{{.Team}}-deploy-key",
+		RotationInterval: 24 * time.Hour,
+		Retries:          2,
+		RetryBackoff:     1 * time.Millisecond,
+		Lenient:          true,
+	}, logger)
+	return handle, func() { server.Close(); githubServer.Close() }
+}
+
+func TestHandlerRetriesSecretWrites(t *testing.T) {
+	tests := []struct {
+		description     string
+		kind            string
+		failures        int
+		expectedStatus  handler.Status
+		expectedActions []handler.ActionType
+		expectedKeys    int
+		expectedWritten bool
+	}{
+		{
+			description:     "retries writes which fail with a transient error",
+			kind:            "data",
+			failures:        2,
+			expectedStatus:  handler.StatusCreated,
+			expectedActions: []handler.ActionType{handler.ActionWriteSecret, handler.ActionCreateKey, handler.ActionWriteSecret},
+			expectedKeys:    1,
+			expectedWritten: true,
+		},
+		{
+			description:     "deletes the new key when the private key cannot be written",
+			kind:            "data",
+			failures:        3,
+			expectedStatus:  handler.StatusFailed,
+			expectedActions: []handler.ActionType{handler.ActionWriteSecret, handler.ActionCreateKey, handler.ActionDeleteKey},
+			expectedKeys:    0,
+		},
+		{
+			description:     "deletes the new key when the labels of the private key cannot be written",
+			kind:            "metadata",
+			failures:        3,
+			expectedStatus:  handler.StatusFailed,
+			expectedActions: []handler.ActionType{handler.ActionWriteSecret, handler.ActionCreateKey, handler.ActionDeleteKey},
+			expectedKeys:    0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			fake := newFakeGithub(100)
+			fake.repositories["telia-oss/test-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("test-repository")}
+			vault := &unavailableVault{fakeVault: newFakeVault("token", ""), kind: tc.kind, match: "deploy-key", failures: tc.failures}
+			lastUpdated := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+			vault.secrets["concourse/test-team/test-repository-deploy-key"] = "previous-private-key"
+			vault.metadata["concourse/test-team/test-repository-deploy-key"] = map[string]string{"last_updated": lastUpdated}
+
+			handle, stop := newRetryTestHandler(t, fake, vault)
+			defer stop()
+
+			result, err := handle(context.Background(), handler.Team{
+				Name:         "test-team",
+				Repositories: []handler.Repository{{Name: "test-repository", Owner: "telia-oss", ReadOnly: true}},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			r := result.Repositories[0]
+			if got, want := r.Status, tc.expectedStatus; got != want {
+				t.Errorf("got status %s, want %s: %s", got, want, r.Reason)
+			}
+			var actions []handler.ActionType
+			for _, a := range r.Actions {
+				actions = append(actions, a.Type)
+			}
+			if !reflect.DeepEqual(actions, tc.expectedActions) {
+				t.Errorf("got actions %v, want %v", actions, tc.expectedActions)
+			}
+			if got, want := len(fake.keys["telia-oss/test-repository"]), tc.expectedKeys; got != want {
+				t.Errorf("got %d deploy keys, want %d", got, want)
+			}
+			// The private key and the time it was written must be left alone when the new key is deleted.
+			if written := vault.secrets["concourse/test-team/test-repository-deploy-key"] != "previous-private-key"; written != tc.expectedWritten {
+				t.Errorf("got private key written %t, want %t", written, tc.expectedWritten)
+			}
+			if updated := vault.metadata["concourse/test-team/test-repository-deploy-key"]["last_updated"] != lastUpdated; updated != tc.expectedWritten {
+				t.Errorf("got last updated changed %t, want %t", updated, tc.expectedWritten)
+			}
+		})
+	}
+}
+
+func TestHandlerDeletesOrphanedKeys(t *testing.T) {
+	fake := newFakeGithub(100)
+	fake.repositories["telia-oss/test-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("test-repository")}
+
+	// The second key was created after the private key was last written, by a run which failed
+	// to write its private key and to delete it again.
+	fake.keys["telia-oss/test-repository"] = []*github.Key{
+		{ID: github.Int64(1), Title: github.String("concourse-test-team-deploy-key"), CreatedAt: &github.Timestamp{Time: time.Now().Add(-3 * time.Hour)}},
+		{ID: github.Int64(2), Title: github.String("concourse-test-team-deploy-key"), CreatedAt: &github.Timestamp{Time: time.Now()}},
+	}
+	vault := newFakeVault("token", "")
+	vault.secrets["concourse/test-team/test-repository-deploy-key"] = "private-key"
+	vault.metadata["concourse/test-team/test-repository-deploy-key"] = map[string]string{
+		"last_updated": time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+	}
+
+	handle, stop := newRetryTestHandler(t, fake, vault)
+	defer stop()
+
+	result, err := handle(context.Background(), handler.Team{
+		Name:         "test-team",
+		Repositories: []handler.Repository{{Name: "test-repository", Owner: "telia-oss", ReadOnly: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := result.Repositories[0]
+	if got, want := r.Status, handler.StatusSkippedFresh; got != want {
+		t.Errorf("got status %s, want %s: %s", got, want, r.Reason)
+	}
+	expected := []handler.Action{
+		{Type: handler.ActionWriteSecret, Target: "/concourse/test-team/telia-oss-access-token"},
+		{Type: handler.ActionDeleteKey, Target: "2"},
+	}
+	if !reflect.DeepEqual(r.Actions, expected) {
+		t.Errorf("got actions %v, want %v", r.Actions, expected)
+	}
+	if keys := fake.keys["telia-oss/test-repository"]; len(keys) != 1 || keys[0].GetID() != 1 {
+		t.Errorf("expected only the previous key to remain, got: %v", keys)
+	}
+}
+
+func TestHandlerDoesNotRetryDeletingKeys(t *testing.T) {
+	fake := newFakeGithub(100)
+	fake.repositories["telia-oss/test-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("test-repository")}
+	vault := &unavailableVault{fakeVault: newFakeVault("token", ""), kind: "data", match: "deploy-key", failures: 3}
+
+	// Requests to Github are retried by the Github client, so the handler must not retry them again.
+	handle, stop := newRetryTestHandler(t, &unavailableGithub{fakeGithub: fake}, vault)
+	defer stop()
+
+	result, err := handle(context.Background(), handler.Team{
+		Name:         "test-team",
+		Repositories: []handler.Repository{{Name: "test-repository", Owner: "telia-oss", ReadOnly: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := result.Repositories[0]
+	if got, want := r.Status, handler.StatusFailed; got != want {
+		t.Errorf("got status %s, want %s", got, want)
+	}
+	if !strings.Contains(r.Reason, "failed to delete the new key") {
+		t.Errorf("unexpected reason: %s", r.Reason)
+	}
+	var deletes int
+	for _, req := range fake.requests {
+		if strings.HasPrefix(req, http.MethodDelete) {
+			deletes++
+		}
+	}
+	if deletes != 1 {
+		t.Errorf("expected the new key to be deleted once, got %d requests", deletes)
+	}
+}
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v29/github"
+)
+
+// RevokeOptions can be set in the event payload to revoke instead of rotating.
+type RevokeOptions struct {
+	// Owner limits the revocation to a single owner. When the team has no name, the deploy keys,
+	// access tokens and secrets of all teams are revoked for the owner.
+	Owner string `json:"owner,omitempty"`
+}
+
+// Revoke deletes deploy keys, revokes access tokens and deletes the secrets written for a team, an owner
+// or a team's repositories on an owner. The repositories are taken from the team's config along with
+// any secrets labelled as belonging to the team (or owner), so that repositories which have since been
+// removed from the config are revoked as well. When revoking all teams for an owner, every repository
+// the key service is installed on is checked for deploy keys matching the title template.
+func (m *Manager) Revoke(ctx context.Context, config Config, team Team, owner string) (*Result, error) {
+	if team.Name == "" && owner == "" {
+		return nil, errors.New("a team or an owner is required")
+	}
+	if hasSelectors(team) {
+		repositories, err := m.resolveRepositories(ctx, team)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve repository selectors: %s", err)
+		}
+		team.Repositories = repositories
+	}
+	result := &Result{Team: team.Name, DryRun: config.DryRun || team.DryRun}
+	inScope := func(t, o string) bool {
+		return (team.Name == "" || t == team.Name) && (owner == "" || strings.EqualFold(o, owner))
+	}
+
+	// Secrets written for a team, keyed by team and repository (or just the owner for access tokens).
+	// The team is empty for repositories where the keys of all teams should be revoked.
+	type target struct {
+		team       string
+		repository Repository
+		secrets    []string
+	}
+	targets := make(map[string]*target)
+	add := func(team string, repository Repository, secret string) {
+		id := strings.ToLower(team + "/" + repository.Owner + "/" + repository.Name)
+		t, ok := targets[id]
+		if !ok {
+			t = &target{team: team, repository: repository}
+			targets[id] = t
+		}
+		if secret == "" {
+			return
+		}
+		for _, s := range t.secrets {
+			if s == secret {
+				return
+			}
+		}
+		t.secrets = append(t.secrets, secret)
+	}
+
+	for _, repository := range team.Repositories {
+		if !inScope(team.Name, repository.Owner) {
+			continue
+		}
+		tokenPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.TokenPath).String()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse token path template: %s", err)
+		}
+		keyPath, err := NewTemplate(team.Name, repository.Name, repository.Owner, config.KeyPath).String()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse deploy key template: %s", err)
+		}
+		add(team.Name, Repository{Owner: repository.Owner}, tokenPath)
+		add(team.Name, Repository{Name: repository.Name, Owner: repository.Owner}, keyPath)
+	}
+
+	// Without a team, the prefix is cut at the team placeholder instead.
+	prefixTeam := team.Name
+	if prefixTeam == "" {
+		prefixTeam = templatePlaceholder
+	}
+	seen := make(map[string]bool)
+	for _, pathTemplate := range []string{config.TokenPath, config.KeyPath} {
+		prefix, err := secretPrefix(prefixTeam, pathTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse path template: %s", err)
+		}
+		if seen[prefix] {
+			continue
+		}
+		seen[prefix] = true
+
+		secrets, err := m.listSecrets(ctx, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets: %s", err)
+		}
+		for _, secret := range secrets {
+			t, o := secret.Labels[labelTeam], secret.Labels[labelOwner]
+			if t == "" || !inScope(t, o) {
+				continue
+			}
+			add(t, Repository{Name: secret.Labels[labelRepository], Owner: o}, secret.Name)
+		}
+	}
+
+	if team.Name == "" {
+		repositories, err := m.listRepositories(ctx, owner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories: %s", err)
+		}
+		for _, repository := range repositories {
+			add("", Repository{Name: repository.GetName(), Owner: owner}, "")
+		}
+	}
+
+	ids := make([]string, 0, len(targets))
+	for id := range targets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		t := targets[id]
+		r := &RepositoryResult{Name: t.repository.Name, Owner: t.repository.Owner}
+		if team.Name == "" {
+			r.Team = t.team
+		}
+		result.Repositories = append(result.Repositories, r)
+
+		status, err := m.revokeRepository(ctx, config, t.team, t.repository, t.secrets, result.DryRun, r)
+		r.Status = status
+		if err != nil {
+			r.Status, r.Reason = StatusFailed, err.Error()
+		}
+	}
+
+	if err := result.Err(); err != nil && !config.Lenient {
+		return result, err
+	}
+	return result, nil
+}
+
+// Delete (or plan the deletion of) the deploy keys on a repository and the given secrets. Access tokens
+// are revoked before their secret is deleted. If team is empty, the deploy keys of all teams are deleted.
+func (m *Manager) revokeRepository(
+	ctx context.Context,
+	config Config,
+	team string,
+	repository Repository,
+	secrets []string,
+	dryRun bool,
+	result *RepositoryResult,
+) (Status, error) {
+	if repository.Name != "" {
+		match, err := titleMatcher(config.KeyTitle, team, repository)
+		if err != nil {
+			return StatusFailed, fmt.Errorf("failed to github title template: %s", err)
+		}
+		keys, err := m.listKeys(ctx, repository)
+		if err != nil && !isNotFound(err) {
+			return StatusFailed, fmt.Errorf("failed to list github keys: %s", err)
+		}
+		var matching []*github.Key
+		for _, key := range keys {
+			if match(key.GetTitle()) {
+				matching = append(matching, key)
+			}
+		}
+		if err := deleteKeys(ctx, m, repository, matching, dryRun, result); err != nil {
+			return StatusFailed, err
+		}
+		if team != "" && !dryRun {
+			if err := m.deleteState(ctx, team, repository); err != nil {
+				return StatusFailed, fmt.Errorf("failed to delete rotation state: %s", err)
+			}
+		}
+	}
+
+	for _, secret := range secrets {
+		if repository.Name == "" {
+			if err := m.revokeSecretToken(ctx, repository.Owner, secret, dryRun, result); err != nil {
+				return StatusFailed, err
+			}
+		}
+
+		var err error
+		if dryRun {
+			_, err = m.getLastUpdated(ctx, secret)
+		} else {
+			err = m.deleteSecret(ctx, secret)
+		}
+		if errors.Is(err, ErrSecretNotFound) {
+			continue
+		}
+		if err != nil {
+			return StatusFailed, fmt.Errorf("failed to delete secret: %s", err)
+		}
+		result.addAction(ActionDeleteSecret, secret)
+	}
+	return StatusRevoked, nil
+}
+
+// Revoke (or plan the revocation of) the access token stored in a secret.
+func (m *Manager) revokeSecretToken(ctx context.Context, owner, secret string, dryRun bool, result *RepositoryResult) error {
+	if dryRun {
+		if _, err := m.getLastUpdated(ctx, secret); err == nil {
+			result.addAction(ActionRevokeToken, secret)
+		}
+		return nil
+	}
+	token, err := m.getSecret(ctx, secret)
+	if errors.Is(err, ErrSecretNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read access token: %s", err)
+	}
+	if err := m.revokeAccessToken(ctx, owner, token); err != nil {
+		return fmt.Errorf("failed to revoke access token: %s", err)
+	}
+	result.addAction(ActionRevokeToken, secret)
+	return nil
+}
+
+// Returns a function which matches the titles of a team's deploy keys on a repository. If the team is empty,
+// the titles of all teams are matched.
+func titleMatcher(keyTitle, team string, repository Repository) (func(string) bool, error) {
+	if team != "" {
+		title, err := NewTemplate(team, repository.Name, repository.Owner, keyTitle).String()
+		if err != nil {
+			return nil, err
+		}
+		return func(s string) bool { return s == title }, nil
+	}
+
+	title, err := NewTemplate(templatePlaceholder, repository.Name, repository.Owner, keyTitle).String()
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := regexp.Compile("^" + strings.Replace(regexp.QuoteMeta(title), templatePlaceholder, ".+", -1) + "$")
+	if err != nil {
+		return nil, err
+	}
+	return pattern.MatchString, nil
+}
@@ -0,0 +1,223 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+func TestManagerRevoke(t *testing.T) {
+	fake := newFakeGithub(100)
+	fake.repositories["telia-oss/current-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("current-repository")}
+	fake.repositories["telia-oss/previous-repository"] = &github.Repository{ID: github.Int64(2), Name: github.String("previous-repository")}
+	fake.keys["telia-oss/current-repository"] = []*github.Key{
+		{ID: github.Int64(1), Title: github.String("some-other-key"), ReadOnly: github.Bool(true)},
+	}
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	vault := newFakeVault("token", "")
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := store.WriteSecret(context.Background(), "/concourse/test-team/manual-secret", "secret", nil); err != nil {
+		t.Fatalf("failed to write secret: %s", err)
+	}
+
+	var revoked []string
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+		TokenClient: func(owner, token string) (handler.AppsClient, error) {
+			revoked = append(revoked, token)
+			return client.Apps, nil
+		},
+	}
+	state := handler.NewMemoryStateStore()
+	manager := handler.NewTestManager(store, handler.NewLocalKeyGenerator(), services, services, state, nil)
+	logger, _ := logrus.NewNullLogger()
+	config := handler.Config{
+		TokenPath: "/concourse/{{.Team}}/{{.Owner}}-access-token",
+		KeyPath:   "/concourse/{{.Team}}/{{.Repository}}-deploy-key",
+		KeyTitle:  "concourse-{{.Team}}-deploy-key",
+	}
+
+	current := handler.Repository{Name: "current-repository", Owner: "telia-oss"}
+	previous := handler.Repository{Name: "previous-repository", Owner: "telia-oss"}
+
+	// Create keys and secrets for both repositories, and remove one of them from the config
+	handle := handler.New(manager, config, logger)
+	if _, err := handle(context.Background(), handler.Team{Name: "test-team", Repositories: []handler.Repository{current, previous}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	team := handler.Team{Name: "test-team", Repositories: []handler.Repository{current}}
+
+	t.Run("plan", func(t *testing.T) {
+		config := config
+		config.DryRun = true
+
+		result, err := manager.Revoke(context.Background(), config, team, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := len(result.Repositories), 3; got != want {
+			t.Fatalf("got %d repositories, want %d: %v", got, want, result.Repositories)
+		}
+		if len(revoked) != 0 {
+			t.Errorf("unexpected revoked tokens: %v", revoked)
+		}
+		for _, r := range result.Repositories {
+			if r.Status != handler.StatusRevoked || len(r.Actions) == 0 {
+				t.Errorf("unexpected result for %s/%s: %v", r.Owner, r.Name, r)
+			}
+		}
+		if got, want := len(fake.keys["telia-oss/previous-repository"]), 1; got != want {
+			t.Errorf("got %d keys, want %d", got, want)
+		}
+		if _, ok := vault.secrets["concourse/test-team/previous-repository-deploy-key"]; !ok {
+			t.Error("expected the secret to be kept")
+		}
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		// Revoke through the event payload
+		team := team
+		team.Revoke = &handler.RevokeOptions{}
+
+		result, err := handler.New(manager, config, logger)(context.Background(), team)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := len(result.Repositories), 3; got != want {
+			t.Fatalf("got %d repositories, want %d: %v", got, want, result.Repositories)
+		}
+		for _, name := range []string{
+			"concourse/test-team/current-repository-deploy-key",
+			"concourse/test-team/previous-repository-deploy-key",
+			"concourse/test-team/telia-oss-access-token",
+		} {
+			if _, ok := vault.secrets[name]; ok {
+				t.Errorf("expected secret to be deleted: %s", name)
+			}
+		}
+		if got, want := revoked, []string{"token"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got revoked tokens %v, want %v", got, want)
+		}
+		if _, ok := vault.secrets["concourse/test-team/manual-secret"]; !ok {
+			t.Error("expected secrets which were not written by the lambda to be kept")
+		}
+		if got := len(fake.keys["telia-oss/previous-repository"]); got != 0 {
+			t.Errorf("expected the deploy key to be deleted, got %d keys", got)
+		}
+		if keys := fake.keys["telia-oss/current-repository"]; len(keys) != 1 || keys[0].GetTitle() != "some-other-key" {
+			t.Errorf("expected only the team's deploy key to be deleted, got: %v", keys)
+		}
+		for _, repository := range []handler.Repository{current, previous} {
+			if _, err := state.GetState(context.Background(), "test-team", repository.Owner, repository.Name); !errors.Is(err, handler.ErrStateNotFound) {
+				t.Errorf("expected the rotation state to be deleted, got: %v", err)
+			}
+		}
+	})
+}
+
+func TestManagerRevokeOwner(t *testing.T) {
+	fake := newFakeGithub(100)
+	fake.repositories["telia-oss/first-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("first-repository")}
+	fake.repositories["telia-oss/second-repository"] = &github.Repository{ID: github.Int64(2), Name: github.String("second-repository")}
+	fake.keys["telia-oss/second-repository"] = []*github.Key{
+		// Keys of a team which is no longer managed by the lambda are also revoked
+		{ID: github.Int64(1), Title: github.String("concourse-old-team-deploy-key"), ReadOnly: github.Bool(true)},
+		{ID: github.Int64(2), Title: github.String("some-other-key"), ReadOnly: github.Bool(true)},
+	}
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	vault := newFakeVault("token", "")
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Secret for the same team on another owner
+	if err := store.WriteSecret(context.Background(), "/concourse/first-team/other-owner-access-token", "other-token", map[string]string{
+		"concourse-github-lambda/team":  "first-team",
+		"concourse-github-lambda/owner": "other-owner",
+	}); err != nil {
+		t.Fatalf("failed to write secret: %s", err)
+	}
+
+	var revoked []string
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+		TokenClient: func(owner, token string) (handler.AppsClient, error) {
+			revoked = append(revoked, owner+":"+token)
+			return client.Apps, nil
+		},
+	}
+	manager := handler.NewTestManager(store, handler.NewLocalKeyGenerator(), services, services, nil, nil)
+	logger, _ := logrus.NewNullLogger()
+	config := handler.Config{
+		TokenPath: "/concourse/{{.Team}}/{{.Owner}}-access-token",
+		KeyPath:   "/concourse/{{.Team}}/{{.Repository}}-deploy-key",
+		KeyTitle:  "concourse-{{.Team}}-deploy-key",
+	}
+
+	handle := handler.New(manager, config, logger)
+	for _, team := range []handler.Team{
+		{Name: "first-team", Repositories: []handler.Repository{{Name: "first-repository", Owner: "telia-oss"}}},
+		{Name: "second-team", Repositories: []handler.Repository{{Name: "first-repository", Owner: "telia-oss"}}},
+	} {
+		if _, err := handle(context.Background(), team); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	result, err := handle(context.Background(), handler.Team{Revoke: &handler.RevokeOptions{Owner: "telia-oss"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, r := range result.Repositories {
+		if r.Status != handler.StatusRevoked {
+			t.Errorf("unexpected result for %s/%s: %v", r.Owner, r.Name, r)
+		}
+	}
+
+	if got, want := revoked, []string{"telia-oss:token", "telia-oss:token"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got revoked tokens %v, want %v", got, want)
+	}
+	if keys := fake.keys["telia-oss/first-repository"]; len(keys) != 0 {
+		t.Errorf("expected the deploy keys of both teams to be deleted, got: %v", keys)
+	}
+	if keys := fake.keys["telia-oss/second-repository"]; len(keys) != 1 || keys[0].GetTitle() != "some-other-key" {
+		t.Errorf("expected only keys matching the title template to be deleted, got: %v", keys)
+	}
+	var secrets []string
+	for name := range vault.secrets {
+		secrets = append(secrets, name)
+	}
+	if got, want := secrets, []string{"concourse/first-team/other-owner-access-token"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got remaining secrets %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+// ErrSecretNotFound is returned by a SecretStore when the secret does not exist.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretStore is the backend where access tokens and private keys are written.
+type SecretStore interface {
+	// WriteSecret creates or updates a secret, records the time it was written and adds the labels.
+	// Labels may be set before the value, but the time is only recorded along with or after the
+	// value, so a failed write never leaves a new value without its labels or moves the time.
+	WriteSecret(ctx context.Context, name, secret string, labels map[string]string) error
+
+	// GetSecret returns the value of a secret written by WriteSecret.
+	GetSecret(ctx context.Context, name string) (string, error)
+
+	// GetMetadata for a secret written by WriteSecret.
+	GetMetadata(ctx context.Context, name string) (*SecretMetadata, error)
+
+	// SetLabels adds or updates labels on a secret. Labels with an empty value are removed.
+	SetLabels(ctx context.Context, name string, labels map[string]string) error
+
+	// DeleteSecret deletes a secret.
+	DeleteSecret(ctx context.Context, name string) error
+
+	// ListSecrets returns the metadata for all secrets with the given prefix.
+	ListSecrets(ctx context.Context, prefix string) ([]*SecretMetadata, error)
+}
+
+// SecretMetadata describes a secret written by the lambda.
+type SecretMetadata struct {
+	Name        string
+	LastUpdated time.Time
+
+	// Labels on the secret. Only guaranteed to be set by ListSecrets.
+	Labels map[string]string
+}
+
+// Split labels into those that should be set and the keys of those that should be removed.
+func splitLabels(labels map[string]string) (set map[string]string, remove []string) {
+	set = make(map[string]string)
+	for k, v := range labels {
+		if v == "" {
+			remove = append(remove, k)
+			continue
+		}
+		set[k] = v
+	}
+	sort.Strings(remove)
+	return set, remove
+}
+
+// Description used for secrets, which also holds the time it was last updated.
+func secretDescription(t time.Time) string {
+	return fmt.Sprintf("Github credentials for Concourse. Last updated: %s", t.UTC().Format(time.RFC3339))
+}
+
+var timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z`)
+
+// Parse the last updated timestamp from a secret description.
+func parseSecretDescription(description string) (time.Time, error) {
+	ds := timestampPattern.FindString(description)
+	if ds == "" {
+		return time.Time{}, fmt.Errorf("failed to find timestamp in description: %s", description)
+	}
+
+	t, err := time.Parse(time.RFC3339, ds)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse timestamp: %s", err)
+	}
+	return t, nil
+}
+
+// NewSecretsManagerStore returns a SecretStore backed by AWS Secrets Manager.
+func NewSecretsManagerStore(sess *session.Session) SecretStore {
+	return NewTestSecretsManagerStore(secretsmanager.New(sess))
+}
+
+// NewTestSecretsManagerStore for testing purposes.
+func NewTestSecretsManagerStore(client SecretsClient) SecretStore {
+	return &secretsManagerStore{client: client}
+}
+
+type secretsManagerStore struct {
+	client SecretsClient
+}
+
+// WriteSecret implements SecretStore.
+func (s *secretsManagerStore) WriteSecret(ctx context.Context, name, secret string, labels map[string]string) error {
+	var err error
+	description := secretDescription(time.Now())
+	tags, _ := splitLabels(labels)
+
+	// The description (which holds the time it was written) is set along with the value below.
+	_, err = s.client.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
+		Name: aws.String(name),
+		Tags: secretsManagerTags(tags),
+	})
+	if err != nil {
+		e, ok := err.(awserr.Error)
+		if !ok {
+			return fmt.Errorf("failed to convert error: %s", err)
+		}
+		if e.Code() != secretsmanager.ErrCodeResourceExistsException {
+			return err
+		}
+		if len(tags) > 0 {
+			_, err = s.client.TagResourceWithContext(ctx, &secretsmanager.TagResourceInput{
+				SecretId: aws.String(name),
+				Tags:     secretsManagerTags(tags),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to tag secret: %s", err)
+			}
+		}
+	}
+
+	_, err = s.client.UpdateSecretWithContext(ctx, &secretsmanager.UpdateSecretInput{
+		Description:  aws.String(description),
+		SecretId:     aws.String(name),
+		SecretString: aws.String(secret),
+	})
+	return err
+}
+
+// GetSecret implements SecretStore.
+func (s *secretsManagerStore) GetSecret(ctx context.Context, name string) (string, error) {
+	out, err := s.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(name),
+	})
+	if err != nil {
+		if e, ok := err.(awserr.Error); ok && e.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return "", ErrSecretNotFound
+		}
+		return "", err
+	}
+	return aws.StringValue(out.SecretString), nil
+}
+
+// GetMetadata implements SecretStore. Note that we are not using LastChangedDate from
+// secrets manager because in practice this timestamp is updated daily by the inner
+// workings of secrets manager.
+func (s *secretsManagerStore) GetMetadata(ctx context.Context, name string) (*SecretMetadata, error) {
+	out, err := s.client.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(name),
+	})
+	if err != nil {
+		if e, ok := err.(awserr.Error); ok && e.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return nil, ErrSecretNotFound
+		}
+		return nil, err
+	}
+
+	t, err := parseSecretDescription(aws.StringValue(out.Description))
+	if err != nil {
+		return nil, err
+	}
+	return &SecretMetadata{Name: name, LastUpdated: t, Labels: secretsManagerLabels(out.Tags)}, nil
+}
+
+// SetLabels implements SecretStore.
+func (s *secretsManagerStore) SetLabels(ctx context.Context, name string, labels map[string]string) error {
+	set, remove := splitLabels(labels)
+	if len(set) > 0 {
+		_, err := s.client.TagResourceWithContext(ctx, &secretsmanager.TagResourceInput{
+			SecretId: aws.String(name),
+			Tags:     secretsManagerTags(set),
+		})
+		if err != nil {
+			if e, ok := err.(awserr.Error); ok && e.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+				return ErrSecretNotFound
+			}
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		_, err := s.client.UntagResourceWithContext(ctx, &secretsmanager.UntagResourceInput{
+			SecretId: aws.String(name),
+			TagKeys:  aws.StringSlice(remove),
+		})
+		if err != nil {
+			if e, ok := err.(awserr.Error); ok && e.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+				return ErrSecretNotFound
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteSecret implements SecretStore.
+func (s *secretsManagerStore) DeleteSecret(ctx context.Context, name string) error {
+	// Skip the recovery window, otherwise the secret cannot be recreated until it has passed.
+	_, err := s.client.DeleteSecretWithContext(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(name),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if err != nil {
+		if e, ok := err.(awserr.Error); ok && e.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return ErrSecretNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ListSecrets implements SecretStore.
+func (s *secretsManagerStore) ListSecrets(ctx context.Context, prefix string) ([]*SecretMetadata, error) {
+	var secrets []*SecretMetadata
+
+	err := s.client.ListSecretsPagesWithContext(ctx, &secretsmanager.ListSecretsInput{
+		Filters: []*secretsmanager.Filter{{
+			Key:    aws.String(secretsmanager.FilterNameStringTypeName),
+			Values: aws.StringSlice([]string{prefix}),
+		}},
+	}, func(page *secretsmanager.ListSecretsOutput, _ bool) bool {
+		for _, secret := range page.SecretList {
+			name := aws.StringValue(secret.Name)
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			// Skip secrets that were not written by the lambda.
+			t, err := parseSecretDescription(aws.StringValue(secret.Description))
+			if err != nil {
+				continue
+			}
+			secrets = append(secrets, &SecretMetadata{Name: name, LastUpdated: t, Labels: secretsManagerLabels(secret.Tags)})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+func secretsManagerTags(labels map[string]string) []*secretsmanager.Tag {
+	if len(labels) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]*secretsmanager.Tag, len(keys))
+	for i, k := range keys {
+		tags[i] = &secretsmanager.Tag{Key: aws.String(k), Value: aws.String(labels[k])}
+	}
+	return tags
+}
+
+func secretsManagerLabels(tags []*secretsmanager.Tag) map[string]string {
+	labels := make(map[string]string, len(tags))
+	for _, t := range tags {
+		labels[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return labels
+}
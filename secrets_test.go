@@ -0,0 +1,170 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/golang/mock/gomock"
+	handler "github.com/telia-oss/concourse-github-lambda"
+	"github.com/telia-oss/concourse-github-lambda/mocks"
+)
+
+func TestSecretsManagerStore(t *testing.T) {
+	timestamp := time.Now().AddDate(0, 0, -1).UTC().Truncate(time.Second)
+	description := "Github credentials for Concourse. Last updated: " + timestamp.Format(time.RFC3339)
+
+	t.Run("tags existing secrets before updating them", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSecretsClient(ctrl)
+		gomock.InOrder(
+			client.EXPECT().CreateSecretWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *secretsmanager.CreateSecretInput, _ ...request.Option) (*secretsmanager.CreateSecretOutput, error) {
+				if input.Description != nil {
+					t.Errorf("expected the description to be set along with the value, got: %s", aws.StringValue(input.Description))
+				}
+				return nil, awserr.New(secretsmanager.ErrCodeResourceExistsException, "exists", nil)
+			}),
+			client.EXPECT().TagResourceWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *secretsmanager.TagResourceInput, _ ...request.Option) (*secretsmanager.TagResourceOutput, error) {
+				if got, want := aws.StringValue(input.SecretId), "/concourse/team/secret"; got != want {
+					t.Errorf("got secret id %s, want %s", got, want)
+				}
+				if got, want := len(input.Tags), 1; got != want {
+					t.Fatalf("got %d tags, want %d", got, want)
+				}
+				if got, want := aws.StringValue(input.Tags[0].Value), "test-team"; got != want {
+					t.Errorf("got tag value %s, want %s", got, want)
+				}
+				return &secretsmanager.TagResourceOutput{}, nil
+			}),
+			client.EXPECT().UpdateSecretWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *secretsmanager.UpdateSecretInput, _ ...request.Option) (*secretsmanager.UpdateSecretOutput, error) {
+				if got, want := aws.StringValue(input.SecretString), "secret"; got != want {
+					t.Errorf("got secret %s, want %s", got, want)
+				}
+				if !strings.HasPrefix(aws.StringValue(input.Description), "Github credentials for Concourse. Last updated: ") {
+					t.Errorf("unexpected description: %s", aws.StringValue(input.Description))
+				}
+				return &secretsmanager.UpdateSecretOutput{}, nil
+			}),
+		)
+
+		if err := handler.NewTestSecretsManagerStore(client).WriteSecret(context.Background(), "/concourse/team/secret", "secret", map[string]string{"team": "test-team"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("sets and removes labels", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSecretsClient(ctrl)
+		client.EXPECT().TagResourceWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *secretsmanager.TagResourceInput, _ ...request.Option) (*secretsmanager.TagResourceOutput, error) {
+			if got, want := aws.StringValue(input.Tags[0].Key), "owner"; got != want {
+				t.Errorf("got tag key %s, want %s", got, want)
+			}
+			return &secretsmanager.TagResourceOutput{}, nil
+		})
+		client.EXPECT().UntagResourceWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *secretsmanager.UntagResourceInput, _ ...request.Option) (*secretsmanager.UntagResourceOutput, error) {
+			if got, want := aws.StringValueSlice(input.TagKeys), []string{"removed"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got tag keys %v, want %v", got, want)
+			}
+			return &secretsmanager.UntagResourceOutput{}, nil
+		})
+
+		err := handler.NewTestSecretsManagerStore(client).SetLabels(context.Background(), "/concourse/team/secret", map[string]string{"owner": "telia-oss", "removed": ""})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("deletes secrets without recovery", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSecretsClient(ctrl)
+		client.EXPECT().DeleteSecretWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *secretsmanager.DeleteSecretInput, _ ...request.Option) (*secretsmanager.DeleteSecretOutput, error) {
+			if !aws.BoolValue(input.ForceDeleteWithoutRecovery) {
+				t.Error("expected force delete without recovery to be set")
+			}
+			return &secretsmanager.DeleteSecretOutput{}, nil
+		})
+
+		if err := handler.NewTestSecretsManagerStore(client).DeleteSecret(context.Background(), "/concourse/team/secret"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("returns not found for missing secrets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notFound := awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil)
+		client := mocks.NewMockSecretsClient(ctrl)
+		client.EXPECT().DeleteSecretWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, notFound)
+		client.EXPECT().TagResourceWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, notFound)
+		client.EXPECT().UntagResourceWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, notFound)
+
+		store := handler.NewTestSecretsManagerStore(client)
+		if err := store.DeleteSecret(context.Background(), "/concourse/team/secret"); !errors.Is(err, handler.ErrSecretNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+		if err := store.SetLabels(context.Background(), "/concourse/team/secret", map[string]string{"owner": "telia-oss"}); !errors.Is(err, handler.ErrSecretNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+		if err := store.SetLabels(context.Background(), "/concourse/team/secret", map[string]string{"removed": ""}); !errors.Is(err, handler.ErrSecretNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+	})
+
+	t.Run("lists secrets written by the lambda", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSecretsClient(ctrl)
+		client.EXPECT().ListSecretsPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(_ aws.Context, input *secretsmanager.ListSecretsInput, fn func(*secretsmanager.ListSecretsOutput, bool) bool, _ ...request.Option) error {
+				if got, want := aws.StringValueSlice(input.Filters[0].Values), []string{"/concourse/team/"}; !reflect.DeepEqual(got, want) {
+					t.Errorf("got filter %v, want %v", got, want)
+				}
+				fn(&secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{
+						{
+							Name:        aws.String("/concourse/team/managed"),
+							Description: aws.String(description),
+							Tags:        []*secretsmanager.Tag{{Key: aws.String("team"), Value: aws.String("test-team")}},
+						},
+						{Name: aws.String("/concourse/team/unmanaged"), Description: aws.String("something else")},
+					},
+				}, false)
+				// The name filter matches anywhere in the name, so the prefix is checked by the store.
+				fn(&secretsmanager.ListSecretsOutput{
+					SecretList: []*secretsmanager.SecretListEntry{
+						{Name: aws.String("/other/concourse/team/managed"), Description: aws.String(description)},
+					},
+				}, true)
+				return nil
+			})
+
+		secrets, err := handler.NewTestSecretsManagerStore(client).ListSecrets(context.Background(), "/concourse/team/")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(secrets) != 1 || secrets[0].Name != "/concourse/team/managed" {
+			t.Fatalf("unexpected secrets: %v", secrets)
+		}
+		if got, want := secrets[0].LastUpdated, timestamp; !got.Equal(want) {
+			t.Errorf("got %s, want %s", got, want)
+		}
+		if got, want := secrets[0].Labels["team"], "test-team"; got != want {
+			t.Errorf("got label %q, want %q", got, want)
+		}
+	})
+}
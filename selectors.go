@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v29/github"
+)
+
+// Resolve the repository selectors of a team to the repositories the key service is installed on. Repositories
+// which are listed by name take precedence over selectors, and the first selector matching a repository wins.
+// Archived repositories are never selected. Returns an error if any selector cannot be resolved, since running
+// with a partial set would have reconciliation remove the rest.
+func (m *Manager) resolveRepositories(ctx context.Context, team Team) ([]Repository, error) {
+	var (
+		resolved []Repository
+		seen     = make(map[string]bool)
+	)
+	for _, r := range team.Repositories {
+		if r.IsSelector() {
+			continue
+		}
+		id := strings.ToLower(r.Owner + "/" + r.Name)
+		if !seen[id] {
+			seen[id] = true
+			resolved = append(resolved, r)
+		}
+	}
+
+	installed := make(map[string][]*github.Repository)
+	for _, selector := range team.Repositories {
+		if !selector.IsSelector() {
+			continue
+		}
+		owner := strings.ToLower(selector.Owner)
+		if _, ok := installed[owner]; !ok {
+			repositories, err := m.listRepositories(ctx, owner)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list repositories for '%s': %s", owner, err)
+			}
+			installed[owner] = repositories
+		}
+
+		matches, err := m.selectRepositories(ctx, selector, installed[owner])
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range matches {
+			id := strings.ToLower(selector.Owner + "/" + name)
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			r := selector
+			r.Name, r.Topics, r.GithubTeam, r.Exclude = name, nil, "", nil
+			resolved = append(resolved, r)
+		}
+	}
+	return resolved, nil
+}
+
+// Returns the (sorted) names of the repositories matching a selector.
+func (m *Manager) selectRepositories(ctx context.Context, selector Repository, repositories []*github.Repository) ([]string, error) {
+	var members map[string]bool
+	if selector.GithubTeam != "" {
+		list, err := m.listTeamRepositories(ctx, selector.Owner, selector.GithubTeam)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories for github team '%s': %s", selector.GithubTeam, err)
+		}
+		members = make(map[string]bool, len(list))
+		for _, r := range list {
+			members[strings.ToLower(r.GetName())] = true
+		}
+	}
+
+	var names []string
+	for _, r := range repositories {
+		name := strings.ToLower(r.GetName())
+		if r.GetArchived() || (members != nil && !members[name]) || !hasTopics(r, selector.Topics) {
+			continue
+		}
+		if selector.Name != "" {
+			ok, err := path.Match(strings.ToLower(selector.Name), name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid repository pattern '%s': %s", selector.Name, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		excluded := false
+		for _, pattern := range selector.Exclude {
+			ok, err := path.Match(strings.ToLower(pattern), name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid exclude pattern '%s': %s", pattern, err)
+			}
+			excluded = excluded || ok
+		}
+		if !excluded {
+			names = append(names, r.GetName())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Returns true if the repository has all of the topics.
+func hasTopics(repository *github.Repository, topics []string) bool {
+	for _, topic := range topics {
+		found := false
+		for _, t := range repository.Topics {
+			if strings.EqualFold(t, topic) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true if any of the team's repositories is a selector.
+func hasSelectors(team Team) bool {
+	for _, r := range team.Repositories {
+		if r.IsSelector() {
+			return true
+		}
+	}
+	return false
+}
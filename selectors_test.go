@@ -0,0 +1,107 @@
+package handler_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+func TestHandlerResolvesSelectors(t *testing.T) {
+	fake := newFakeGithub(2)
+	for i, r := range []*github.Repository{
+		{Name: github.String("payments-api"), Topics: []string{"java"}},
+		{Name: github.String("payments-web"), Archived: github.Bool(true)},
+		{Name: github.String("payments-old")},
+		{Name: github.String("billing"), Topics: []string{"payments", "go"}},
+		{Name: github.String("ledger"), Topics: []string{"Payments"}},
+		{Name: github.String("website")},
+	} {
+		r.ID = github.Int64(int64(i + 1))
+		fake.repositories["telia-oss/"+r.GetName()] = r
+	}
+	fake.teams["telia-oss/payments"] = []*github.Repository{
+		{Name: github.String("billing")},
+		{Name: github.String("website")},
+	}
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	server := httptest.NewServer(newFakeVault("token", ""))
+	defer server.Close()
+
+	store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Teams: client.Teams, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+	}
+	manager := handler.NewTestManager(store, handler.NewLocalKeyGenerator(), services, services, nil, nil)
+	logger, hook := logrus.NewNullLogger()
+	config := handler.Config{
+		TokenPath: "/concourse/{{.Team}}/{{.Owner}}-access-token",
+		KeyPath:   "/concourse/{{.Team}}/{{.Repository}}-deploy-key",
+		KeyTitle:  "concourse-{{.Team}}-deploy-key",
+	}
+
+	team := handler.Team{
+		Name: "test-team",
+		Repositories: []handler.Repository{
+			{Name: "payments-*", Owner: "telia-oss", ReadOnly: true, Exclude: []string{"*-old"}},
+			{Owner: "telia-oss", Topics: []string{"payments"}, ReadOnly: true},
+			{Owner: "telia-oss", GithubTeam: "payments", Exclude: []string{"billing"}, ReadOnly: true},
+			// Repositories listed by name take precedence over selectors
+			{Name: "payments-api", Owner: "telia-oss", ReadOnly: false},
+		},
+	}
+	result, err := handler.New(manager, config, logger)(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, r := range result.Repositories {
+		got = append(got, r.Name)
+	}
+	want := []string{"payments-api", "billing", "ledger", "website"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got repositories %v, want %v", got, want)
+	}
+	if keys := fake.keys["telia-oss/payments-api"]; len(keys) != 1 || keys[0].GetReadOnly() {
+		t.Errorf("expected a read-write key for the repository listed by name, got: %v", keys)
+	}
+	if keys := fake.keys["telia-oss/website"]; len(keys) != 1 || !keys[0].GetReadOnly() {
+		t.Errorf("expected a read-only key for the selected repository, got: %v", keys)
+	}
+
+	var logged bool
+	for _, e := range hook.AllEntries() {
+		if e.Message == "resolved repository selectors" {
+			logged = true
+		}
+	}
+	if !logged {
+		t.Error("expected the resolved repositories to be logged")
+	}
+
+	t.Run("fails when a selector cannot be resolved", func(t *testing.T) {
+		team := handler.Team{
+			Name:         "test-team",
+			Repositories: []handler.Repository{{Owner: "telia-oss", GithubTeam: "missing"}},
+		}
+		if _, err := handler.New(manager, config, logger)(context.Background(), team); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
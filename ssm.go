@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+// SSMClient for testing purposes.
+//go:generate mockgen -destination=mocks/mock_ssm_client.go -package=mocks github.com/telia-oss/concourse-github-lambda SSMClient
+type SSMClient ssmiface.SSMAPI
+
+// NewSSMStore returns a SecretStore backed by SSM Parameter Store. Secrets are
+// written as SecureString parameters, and the time they were last updated is
+// stored in the parameter description.
+func NewSSMStore(sess *session.Session) SecretStore {
+	return NewTestSSMStore(ssm.New(sess))
+}
+
+// NewTestSSMStore for testing purposes.
+func NewTestSSMStore(client SSMClient) SecretStore {
+	return &ssmStore{client: client}
+}
+
+type ssmStore struct {
+	client SSMClient
+}
+
+// WriteSecret implements SecretStore.
+func (s *ssmStore) WriteSecret(ctx context.Context, name, secret string, labels map[string]string) error {
+	input := &ssm.PutParameterInput{
+		Name:        aws.String(name),
+		Description: aws.String(secretDescription(time.Now())),
+		Value:       aws.String(secret),
+		Type:        aws.String(ssm.ParameterTypeSecureString),
+		Overwrite:   aws.Bool(true),
+	}
+
+	// Tags cannot be set by PutParameter when overwriting a parameter, so existing parameters are
+	// tagged before the value is written (which means the value is never replaced without its labels).
+	tags, _ := splitLabels(labels)
+	if len(tags) > 0 {
+		err := s.SetLabels(ctx, name, tags)
+		switch {
+		case errors.Is(err, ErrSecretNotFound):
+			input.Overwrite, input.Tags = aws.Bool(false), ssmTags(tags)
+		case err != nil:
+			return err
+		}
+	}
+
+	_, err := s.client.PutParameterWithContext(ctx, input)
+	return err
+}
+
+// GetSecret implements SecretStore.
+func (s *ssmStore) GetSecret(ctx context.Context, name string) (string, error) {
+	out, err := s.client.GetParameterWithContext(ctx, &ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", ssmError(err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// GetMetadata implements SecretStore.
+func (s *ssmStore) GetMetadata(ctx context.Context, name string) (*SecretMetadata, error) {
+	out, err := s.client.DescribeParametersWithContext(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice([]string{name}),
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Parameters) == 0 {
+		return nil, ErrSecretNotFound
+	}
+
+	t, err := parseSecretDescription(aws.StringValue(out.Parameters[0].Description))
+	if err != nil {
+		return nil, err
+	}
+	return &SecretMetadata{Name: name, LastUpdated: t}, nil
+}
+
+// SetLabels implements SecretStore.
+func (s *ssmStore) SetLabels(ctx context.Context, name string, labels map[string]string) error {
+	set, remove := splitLabels(labels)
+	if len(set) > 0 {
+		_, err := s.client.AddTagsToResourceWithContext(ctx, &ssm.AddTagsToResourceInput{
+			ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+			ResourceId:   aws.String(name),
+			Tags:         ssmTags(set),
+		})
+		if err != nil {
+			return ssmError(err)
+		}
+	}
+	if len(remove) > 0 {
+		_, err := s.client.RemoveTagsFromResourceWithContext(ctx, &ssm.RemoveTagsFromResourceInput{
+			ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+			ResourceId:   aws.String(name),
+			TagKeys:      aws.StringSlice(remove),
+		})
+		if err != nil {
+			return ssmError(err)
+		}
+	}
+	return nil
+}
+
+// DeleteSecret implements SecretStore.
+func (s *ssmStore) DeleteSecret(ctx context.Context, name string) error {
+	_, err := s.client.DeleteParameterWithContext(ctx, &ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return ssmError(err)
+	}
+	return nil
+}
+
+// ListSecrets implements SecretStore. Note that tags are not included when describing
+// parameters, so they are listed separately for each parameter.
+func (s *ssmStore) ListSecrets(ctx context.Context, prefix string) ([]*SecretMetadata, error) {
+	var secrets []*SecretMetadata
+
+	err := s.client.DescribeParametersPagesWithContext(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("BeginsWith"),
+			Values: aws.StringSlice([]string{prefix}),
+		}},
+	}, func(page *ssm.DescribeParametersOutput, _ bool) bool {
+		for _, p := range page.Parameters {
+			name := aws.StringValue(p.Name)
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			// Skip parameters that were not written by the lambda.
+			t, err := parseSecretDescription(aws.StringValue(p.Description))
+			if err != nil {
+				continue
+			}
+			secrets = append(secrets, &SecretMetadata{Name: name, LastUpdated: t})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		out, err := s.client.ListTagsForResourceWithContext(ctx, &ssm.ListTagsForResourceInput{
+			ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+			ResourceId:   aws.String(secret.Name),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags for parameter: %s: %s", secret.Name, err)
+		}
+		secret.Labels = make(map[string]string, len(out.TagList))
+		for _, t := range out.TagList {
+			secret.Labels[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+	}
+	return secrets, nil
+}
+
+// Convert labels to SSM tags, sorted by key.
+func ssmTags(labels map[string]string) []*ssm.Tag {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]*ssm.Tag, len(keys))
+	for i, k := range keys {
+		tags[i] = &ssm.Tag{Key: aws.String(k), Value: aws.String(labels[k])}
+	}
+	return tags
+}
+
+// Convert parameter not found errors to ErrSecretNotFound.
+func ssmError(err error) error {
+	if e, ok := err.(awserr.Error); ok && (e.Code() == ssm.ErrCodeParameterNotFound || e.Code() == ssm.ErrCodeInvalidResourceId) {
+		return ErrSecretNotFound
+	}
+	return err
+}
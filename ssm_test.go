@@ -0,0 +1,200 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/golang/mock/gomock"
+	handler "github.com/telia-oss/concourse-github-lambda"
+	"github.com/telia-oss/concourse-github-lambda/mocks"
+)
+
+func TestSSMStore(t *testing.T) {
+	timestamp := time.Now().AddDate(0, 0, -1).UTC().Truncate(time.Second)
+	description := "Github credentials for Concourse. Last updated: " + timestamp.Format(time.RFC3339)
+
+	t.Run("writes secure string parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		client.EXPECT().PutParameterWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *ssm.PutParameterInput, _ ...request.Option) (*ssm.PutParameterOutput, error) {
+			if got, want := aws.StringValue(input.Type), ssm.ParameterTypeSecureString; got != want {
+				t.Errorf("got type %s, want %s", got, want)
+			}
+			if !aws.BoolValue(input.Overwrite) {
+				t.Error("expected overwrite to be set")
+			}
+			if !strings.HasPrefix(aws.StringValue(input.Description), "Github credentials for Concourse. Last updated: ") {
+				t.Errorf("unexpected description: %s", aws.StringValue(input.Description))
+			}
+			return &ssm.PutParameterOutput{}, nil
+		})
+
+		if err := handler.NewTestSSMStore(client).WriteSecret(context.Background(), "/concourse/team/secret", "secret", nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("reads the last updated timestamp from the description", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		client.EXPECT().DescribeParametersWithContext(gomock.Any(), gomock.Any()).Times(1).Return(&ssm.DescribeParametersOutput{
+			Parameters: []*ssm.ParameterMetadata{{
+				Name:        aws.String("/concourse/team/secret"),
+				Description: aws.String(description),
+			}},
+		}, nil)
+
+		metadata, err := handler.NewTestSSMStore(client).GetMetadata(context.Background(), "/concourse/team/secret")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := metadata.LastUpdated, timestamp; !got.Equal(want) {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("reads decrypted parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		client.EXPECT().GetParameterWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *ssm.GetParameterInput, _ ...request.Option) (*ssm.GetParameterOutput, error) {
+			if !aws.BoolValue(input.WithDecryption) {
+				t.Error("expected with decryption to be set")
+			}
+			return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String("secret")}}, nil
+		})
+
+		secret, err := handler.NewTestSSMStore(client).GetSecret(context.Background(), "/concourse/team/secret")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := secret, "secret"; got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("returns not found for missing parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		client.EXPECT().DescribeParametersWithContext(gomock.Any(), gomock.Any()).Times(1).Return(&ssm.DescribeParametersOutput{}, nil)
+		client.EXPECT().DeleteParameterWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+
+		store := handler.NewTestSSMStore(client)
+		if _, err := store.GetMetadata(context.Background(), "/concourse/team/secret"); !errors.Is(err, handler.ErrSecretNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+		if err := store.DeleteSecret(context.Background(), "/concourse/team/secret"); !errors.Is(err, handler.ErrSecretNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+	})
+
+	t.Run("lists parameters written by the lambda", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		client.EXPECT().DescribeParametersPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(_ aws.Context, _ *ssm.DescribeParametersInput, fn func(*ssm.DescribeParametersOutput, bool) bool, _ ...request.Option) error {
+				fn(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{Name: aws.String("/concourse/team/managed"), Description: aws.String(description)},
+						{Name: aws.String("/concourse/team/unmanaged"), Description: aws.String("something else")},
+					},
+				}, true)
+				return nil
+			})
+		client.EXPECT().ListTagsForResourceWithContext(gomock.Any(), gomock.Any()).Times(1).Return(&ssm.ListTagsForResourceOutput{
+			TagList: []*ssm.Tag{{Key: aws.String("team"), Value: aws.String("test-team")}},
+		}, nil)
+
+		secrets, err := handler.NewTestSSMStore(client).ListSecrets(context.Background(), "/concourse/team/")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(secrets) != 1 || secrets[0].Name != "/concourse/team/managed" {
+			t.Fatalf("unexpected secrets: %v", secrets)
+		}
+		if got, want := secrets[0].Labels["team"], "test-team"; got != want {
+			t.Errorf("got label %q, want %q", got, want)
+		}
+	})
+
+	t.Run("creates new parameters with tags", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		gomock.InOrder(
+			client.EXPECT().AddTagsToResourceWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, awserr.New(ssm.ErrCodeInvalidResourceId, "not found", nil)),
+			client.EXPECT().PutParameterWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *ssm.PutParameterInput, _ ...request.Option) (*ssm.PutParameterOutput, error) {
+				if aws.BoolValue(input.Overwrite) {
+					t.Error("expected overwrite to not be set")
+				}
+				if got, want := len(input.Tags), 1; got != want {
+					t.Fatalf("got %d tags, want %d", got, want)
+				}
+				return &ssm.PutParameterOutput{}, nil
+			}),
+		)
+
+		if err := handler.NewTestSSMStore(client).WriteSecret(context.Background(), "/concourse/team/secret", "secret", map[string]string{"team": "test-team"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("does not write the value when tagging fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		client.EXPECT().AddTagsToResourceWithContext(gomock.Any(), gomock.Any()).Times(1).Return(nil, awserr.New(ssm.ErrCodeTooManyUpdates, "too many updates", nil))
+		client.EXPECT().PutParameterWithContext(gomock.Any(), gomock.Any()).Times(0)
+
+		if err := handler.NewTestSSMStore(client).WriteSecret(context.Background(), "/concourse/team/secret", "secret", map[string]string{"team": "test-team"}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("tags parameters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		client := mocks.NewMockSSMClient(ctrl)
+		client.EXPECT().PutParameterWithContext(gomock.Any(), gomock.Any()).Times(1).Return(&ssm.PutParameterOutput{}, nil)
+		client.EXPECT().AddTagsToResourceWithContext(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ aws.Context, input *ssm.AddTagsToResourceInput, _ ...request.Option) (*ssm.AddTagsToResourceOutput, error) {
+			if got, want := aws.StringValue(input.ResourceType), ssm.ResourceTypeForTaggingParameter; got != want {
+				t.Errorf("got resource type %s, want %s", got, want)
+			}
+			return &ssm.AddTagsToResourceOutput{}, nil
+		})
+		client.EXPECT().RemoveTagsFromResourceWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *ssm.RemoveTagsFromResourceInput, _ ...request.Option) (*ssm.RemoveTagsFromResourceOutput, error) {
+			if got, want := aws.StringValueSlice(input.TagKeys), []string{"removed"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got tag keys %v, want %v", got, want)
+			}
+			return &ssm.RemoveTagsFromResourceOutput{}, nil
+		})
+
+		store := handler.NewTestSSMStore(client)
+		if err := store.WriteSecret(context.Background(), "/concourse/team/secret", "secret", map[string]string{"team": "test-team"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err := store.SetLabels(context.Background(), "/concourse/team/secret", map[string]string{"owner": "telia-oss", "removed": ""}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+}
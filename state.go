@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+)
+
+// ErrStateNotFound is returned by a StateStore when there is no state for a repository.
+var ErrStateNotFound = errors.New("state not found")
+
+// StateStore records the rotation state of the deploy key for each team and repository.
+type StateStore interface {
+	// GetState for a team's repository.
+	GetState(ctx context.Context, team, owner, repository string) (*RepositoryState, error)
+
+	// PutState creates or replaces the state for a team's repository.
+	PutState(ctx context.Context, state *RepositoryState) error
+
+	// DeleteState for a team's repository.
+	DeleteState(ctx context.Context, team, owner, repository string) error
+}
+
+// RepositoryState is the rotation state of a team's deploy key for a repository.
+type RepositoryState struct {
+	Team        string    `dynamodbav:"-"`
+	Owner       string    `dynamodbav:"owner"`
+	Repository  string    `dynamodbav:"name"`
+	KeyID       int64     `dynamodbav:"keyId"`
+	Fingerprint string    `dynamodbav:"fingerprint"`
+	KeyType     KeyType   `dynamodbav:"keyType"`
+	CreatedAt   time.Time `dynamodbav:"createdAt"`
+	LastRotated time.Time `dynamodbav:"lastRotated"`
+
+	// PendingDeletion are the IDs of superseded deploy keys which have not been deleted yet.
+	PendingDeletion []int64 `dynamodbav:"pendingDeletion,omitempty"`
+}
+
+// NewMemoryStateStore returns a StateStore which only keeps the state in memory.
+func NewMemoryStateStore() StateStore {
+	return &memoryStateStore{states: make(map[string]RepositoryState)}
+}
+
+type memoryStateStore struct {
+	mu     sync.Mutex
+	states map[string]RepositoryState
+}
+
+func stateKey(team, owner, repository string) string {
+	return fmt.Sprintf("%s/%s/%s", team, owner, repository)
+}
+
+// GetState implements StateStore.
+func (s *memoryStateStore) GetState(ctx context.Context, team, owner, repository string) (*RepositoryState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[stateKey(team, owner, repository)]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+	state.PendingDeletion = append([]int64(nil), state.PendingDeletion...)
+	return &state, nil
+}
+
+// PutState implements StateStore.
+func (s *memoryStateStore) PutState(ctx context.Context, state *RepositoryState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := *state
+	c.PendingDeletion = append([]int64(nil), state.PendingDeletion...)
+	s.states[stateKey(state.Team, state.Owner, state.Repository)] = c
+	return nil
+}
+
+// DeleteState implements StateStore.
+func (s *memoryStateStore) DeleteState(ctx context.Context, team, owner, repository string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.states, stateKey(team, owner, repository))
+	return nil
+}
+
+// DynamoDBClient for testing purposes.
+//go:generate mockgen -destination=mocks/mock_dynamodb_client.go -package=mocks github.com/telia-oss/concourse-github-lambda DynamoDBClient
+type DynamoDBClient dynamodbiface.DynamoDBAPI
+
+// NewDynamoDBStateStore returns a StateStore backed by a DynamoDB table, which must have
+// "team" as the partition key and "repository" as the sort key (both strings).
+func NewDynamoDBStateStore(sess *session.Session, table string) StateStore {
+	return NewTestDynamoDBStateStore(dynamodb.New(sess), table)
+}
+
+// NewTestDynamoDBStateStore for testing purposes.
+func NewTestDynamoDBStateStore(client DynamoDBClient, table string) StateStore {
+	return &dynamoDBStateStore{client: client, table: table}
+}
+
+type dynamoDBStateStore struct {
+	client DynamoDBClient
+	table  string
+}
+
+// Primary key of the item for a team's repository.
+func (s *dynamoDBStateStore) key(team, owner, repository string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"team":       {S: aws.String(team)},
+		"repository": {S: aws.String(owner + "/" + repository)},
+	}
+}
+
+// GetState implements StateStore.
+func (s *dynamoDBStateStore) GetState(ctx context.Context, team, owner, repository string) (*RepositoryState, error) {
+	out, err := s.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(s.table),
+		Key:            s.key(team, owner, repository),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Item) == 0 {
+		return nil, ErrStateNotFound
+	}
+
+	var state RepositoryState
+	if err := dynamodbattribute.UnmarshalMap(out.Item, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal state: %s", err)
+	}
+	state.Team = team
+	return &state, nil
+}
+
+// PutState implements StateStore.
+func (s *dynamoDBStateStore) PutState(ctx context.Context, state *RepositoryState) error {
+	item, err := dynamodbattribute.MarshalMap(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %s", err)
+	}
+	for k, v := range s.key(state.Team, state.Owner, state.Repository) {
+		item[k] = v
+	}
+
+	_, err = s.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(s.table),
+		Item:      item,
+	})
+	return err
+}
+
+// DeleteState implements StateStore.
+func (s *dynamoDBStateStore) DeleteState(ctx context.Context, team, owner, repository string) error {
+	_, err := s.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.table),
+		Key:       s.key(team, owner, repository),
+	})
+	return err
+}
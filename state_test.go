@@ -0,0 +1,229 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+	"github.com/telia-oss/concourse-github-lambda/mocks"
+)
+
+func TestStateStore(t *testing.T) {
+	state := &handler.RepositoryState{
+		Team:            "test-team",
+		Owner:           "telia-oss",
+		Repository:      "test-repository",
+		KeyID:           2,
+		Fingerprint:     "SHA256:fingerprint",
+		KeyType:         handler.KeyTypeEd25519,
+		CreatedAt:       time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC),
+		LastRotated:     time.Date(2020, 8, 1, 12, 0, 1, 0, time.UTC),
+		PendingDeletion: []int64{1},
+	}
+
+	t.Run("memory", func(t *testing.T) {
+		store := handler.NewMemoryStateStore()
+		if _, err := store.GetState(context.Background(), "test-team", "telia-oss", "test-repository"); !errors.Is(err, handler.ErrStateNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+		if err := store.PutState(context.Background(), state); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got, err := store.GetState(context.Background(), "test-team", "telia-oss", "test-repository")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(got, state) {
+			t.Errorf("\ngot:\n%v\nwant:\n%v\n", got, state)
+		}
+		if err := store.DeleteState(context.Background(), "test-team", "telia-oss", "test-repository"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err := store.GetState(context.Background(), "test-team", "telia-oss", "test-repository"); !errors.Is(err, handler.ErrStateNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+	})
+
+	t.Run("dynamodb", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var item map[string]*dynamodb.AttributeValue
+		client := mocks.NewMockDynamoDBClient(ctrl)
+		client.EXPECT().PutItemWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *dynamodb.PutItemInput, _ ...request.Option) (*dynamodb.PutItemOutput, error) {
+			if got, want := aws.StringValue(input.TableName), "state"; got != want {
+				t.Errorf("got table %s, want %s", got, want)
+			}
+			item = input.Item
+			return &dynamodb.PutItemOutput{}, nil
+		})
+		client.EXPECT().GetItemWithContext(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ aws.Context, input *dynamodb.GetItemInput, _ ...request.Option) (*dynamodb.GetItemOutput, error) {
+			if aws.StringValue(input.Key["repository"].S) != "telia-oss/test-repository" {
+				return &dynamodb.GetItemOutput{}, nil
+			}
+			return &dynamodb.GetItemOutput{Item: item}, nil
+		})
+		client.EXPECT().DeleteItemWithContext(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ aws.Context, input *dynamodb.DeleteItemInput, _ ...request.Option) (*dynamodb.DeleteItemOutput, error) {
+			if got, want := aws.StringValue(input.Key["team"].S), "test-team"; got != want {
+				t.Errorf("got team %s, want %s", got, want)
+			}
+			return &dynamodb.DeleteItemOutput{}, nil
+		})
+
+		store := handler.NewTestDynamoDBStateStore(client, "state")
+		if err := store.PutState(context.Background(), state); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := aws.StringValue(item["team"].S), "test-team"; got != want {
+			t.Errorf("got partition key %s, want %s", got, want)
+		}
+		if got, want := aws.StringValue(item["repository"].S), "telia-oss/test-repository"; got != want {
+			t.Errorf("got sort key %s, want %s", got, want)
+		}
+
+		got, err := store.GetState(context.Background(), "test-team", "telia-oss", "test-repository")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(got, state) {
+			t.Errorf("\ngot:\n%v\nwant:\n%v\n", got, state)
+		}
+		if _, err := store.GetState(context.Background(), "test-team", "telia-oss", "other-repository"); !errors.Is(err, handler.ErrStateNotFound) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+		if err := store.DeleteState(context.Background(), "test-team", "telia-oss", "test-repository"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+}
+
+func TestHandlerStateStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fake := newFakeGithub(100)
+	fake.repositories["telia-oss/test-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("test-repository")}
+
+	client, stop := fake.Start(t)
+	defer stop()
+
+	// The rotation time is never read from the secret description when the state store has recorded the key.
+	secrets := mocks.NewMockSecretsClient(ctrl)
+	expectNoPreviousToken(secrets)
+	secrets.EXPECT().CreateSecretWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+	secrets.EXPECT().UpdateSecretWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+
+	services := &handler.GithubApp{
+		App:           client.Apps,
+		Installations: map[string]int64{"telia-oss": 1},
+		Clients: map[string]*handler.GithubClient{
+			"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+		},
+	}
+	state := handler.NewMemoryStateStore()
+	manager := handler.NewTestManager(handler.NewTestSecretsManagerStore(secrets), handler.NewLocalKeyGenerator(), services, services, state, nil)
+	logger, _ := logrus.NewNullLogger()
+	handle := handler.New(manager, handler.Config{
+		TokenPath:  "/concourse/{{.Team}}/{{.Owner}}",
+		KeyPath:    "/concourse/{{.Team}}/{{.Repository}}",
+		KeyTitle:   "concourse-{{.Team}}-deploy-key",
+		KeyOverlap: 1 * time.Hour,
+	}, logger)
+
+	team := handler.Team{
+		Name:         "test-team",
+		KeyType:      handler.KeyTypeEd25519,
+		Repositories: []handler.Repository{{Name: "test-repository", Owner: "telia-oss"}},
+	}
+
+	// Creating a key records the state
+	result, err := handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := result.Repositories[0].Status, handler.StatusCreated; got != want {
+		t.Fatalf("got status %s, want %s", got, want)
+	}
+	s, err := state.GetState(context.Background(), "test-team", "telia-oss", "test-repository")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	key := fake.keys["telia-oss/test-repository"][0]
+	if got, want := s.KeyID, key.GetID(); got != want {
+		t.Errorf("got key id %d, want %d", got, want)
+	}
+	if got, want := s.KeyType, handler.KeyTypeEd25519; got != want {
+		t.Errorf("got key type %s, want %s", got, want)
+	}
+	if !strings.HasPrefix(s.Fingerprint, "SHA256:") {
+		t.Errorf("unexpected fingerprint: %s", s.Fingerprint)
+	}
+	if time.Since(s.LastRotated) > time.Minute || s.CreatedAt.IsZero() {
+		t.Errorf("unexpected timestamps: %v", s)
+	}
+
+	// The key is fresh according to the state
+	result, err = handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := result.Repositories[0].Status, handler.StatusSkippedFresh; got != want {
+		t.Fatalf("got status %s, want %s", got, want)
+	}
+
+	// Rotating records the superseded key as pending deletion
+	s.LastRotated = time.Now().AddDate(0, 0, -10)
+	if err := state.PutState(context.Background(), s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	result, err = handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := result.Repositories[0].Status, handler.StatusRotated; got != want {
+		t.Fatalf("got status %s, want %s", got, want)
+	}
+	s, err = state.GetState(context.Background(), "test-team", "telia-oss", "test-repository")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := s.PendingDeletion, []int64{key.GetID()}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got pending deletion %v, want %v", got, want)
+	}
+	if s.KeyID == key.GetID() {
+		t.Errorf("expected a new key to be recorded")
+	}
+
+	// Superseded keys are deleted once the overlap has passed
+	s.LastRotated = time.Now().Add(-2 * time.Hour)
+	if err := state.PutState(context.Background(), s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	result, err = handle(context.Background(), team)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := result.Repositories[0].Status, handler.StatusSkippedFresh; got != want {
+		t.Fatalf("got status %s, want %s", got, want)
+	}
+	if got, want := len(fake.keys["telia-oss/test-repository"]), 1; got != want {
+		t.Errorf("got %d keys, want %d", got, want)
+	}
+	s, err = state.GetState(context.Background(), "test-team", "telia-oss", "test-repository")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(s.PendingDeletion) != 0 {
+		t.Errorf("expected no keys pending deletion, got: %v", s.PendingDeletion)
+	}
+}
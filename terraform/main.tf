@@ -54,6 +54,14 @@ module "team" {
       name     = "go-hooks"
       owner    = "itsdalmo"
       readOnly = true
+      keyType  = "ed25519"
+    },
+    {
+      name             = "concourse-*"
+      owner            = "telia-oss"
+      readOnly         = true
+      exclude          = ["concourse-sts-lambda"]
+      rotationInterval = "7d"
     },
   ]
 }
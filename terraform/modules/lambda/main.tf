@@ -31,23 +31,69 @@ module "lambda" {
     GITHUB_TOKEN_SERVICE_PRIVATE_KEY    = var.token_service_private_key
     GITHUB_KEY_SERVICE_INTEGRATION_ID   = var.key_service_integration_id
     GITHUB_KEY_SERVICE_PRIVATE_KEY      = var.key_service_private_key
+    GITHUB_BASE_URL                     = var.github_base_url
+    GITHUB_OWNER_ENDPOINTS              = can(tostring(var.github_owner_endpoints)) ? var.github_owner_endpoints : jsonencode(var.github_owner_endpoints)
+    GITHUB_RETRIES                      = var.github_retries
+    GITHUB_MIN_REMAINING                = var.github_min_remaining
+    SECRET_RETRIES                      = var.secret_retries
+    VERIFY_KEYS                         = var.verify_keys
+    VERIFY_SSH_HOST                     = var.verify_ssh_host
+    VERIFY_SSH_HOST_KEYS                = join(",", var.verify_ssh_host_keys)
+    KEY_GENERATOR                       = var.key_generator
+    SECRET_STORE                        = var.secret_store
+    VAULT_ADDR                          = var.vault_address
+    VAULT_MOUNT                         = var.vault_mount
+    VAULT_AUTH_METHOD                   = var.vault_auth_method
+    VAULT_TOKEN                         = var.vault_token
+    VAULT_AWS_MOUNT                     = var.vault_aws_mount
+    VAULT_AWS_ROLE                      = var.vault_aws_role
+    VAULT_AWS_SERVER_ID                 = var.vault_aws_server_id
+    STATE_STORE                         = var.state_store
+    STATE_TABLE                         = var.state_store == "dynamodb" ? aws_dynamodb_table.state[0].name : ""
+    RECONCILE                           = var.reconcile
+    REMOVAL_DELAY                       = var.removal_delay
+    TOKEN_GRACE_PERIOD                  = var.token_grace_period
+  }
+
+  tags = var.tags
+}
+
+resource "aws_dynamodb_table" "state" {
+  count        = var.state_store == "dynamodb" ? 1 : 0
+  name         = "${var.name_prefix}-state"
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "team"
+  range_key    = "repository"
+
+  attribute {
+    name = "team"
+    type = "S"
+  }
+
+  attribute {
+    name = "repository"
+    type = "S"
   }
 
   tags = var.tags
 }
 
 data "aws_iam_policy_document" "lambda" {
-  statement {
-    effect = "Allow"
+  dynamic "statement" {
+    for_each = var.key_generator == "ec2" ? [1] : []
 
-    actions = [
-      "ec2:CreateKeyPair",
-      "ec2:DeleteKeyPair",
-    ]
+    content {
+      effect = "Allow"
 
-    resources = [
-      "*",
-    ]
+      actions = [
+        "ec2:CreateKeyPair",
+        "ec2:DeleteKeyPair",
+      ]
+
+      resources = [
+        "*",
+      ]
+    }
   }
 
   statement {
@@ -66,18 +112,96 @@ data "aws_iam_policy_document" "lambda" {
 
   // https://docs.aws.amazon.com/secretsmanager/latest/apireference/API_CreateSecret.html
   // https://docs.aws.amazon.com/secretsmanager/latest/apireference/API_UpdateSecret.html
-  statement {
-    effect = "Allow"
+  dynamic "statement" {
+    for_each = var.secret_store == "secretsmanager" ? [1] : []
+
+    content {
+      effect = "Allow"
+
+      actions = [
+        "secretsmanager:CreateSecret",
+        "secretsmanager:UpdateSecret",
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:DeleteSecret",
+        "secretsmanager:TagResource",
+        "secretsmanager:UntagResource",
+      ]
+
+      resources = [
+        "arn:aws:secretsmanager:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:secret:/${var.secrets_manager_prefix}/*",
+      ]
+    }
+  }
 
-    actions = [
-      "secretsmanager:CreateSecret",
-      "secretsmanager:UpdateSecret",
-      "secretsmanager:DescribeSecret",
-    ]
+  dynamic "statement" {
+    for_each = var.secret_store == "secretsmanager" ? [1] : []
 
-    resources = [
-      "arn:aws:secretsmanager:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:secret:/${var.secrets_manager_prefix}/*",
-    ]
+    content {
+      effect = "Allow"
+
+      actions = [
+        "secretsmanager:ListSecrets",
+      ]
+
+      resources = [
+        "*",
+      ]
+    }
   }
-}
 
+  dynamic "statement" {
+    for_each = var.secret_store == "ssm" ? [1] : []
+
+    content {
+      effect = "Allow"
+
+      actions = [
+        "ssm:PutParameter",
+        "ssm:DeleteParameter",
+        "ssm:GetParameter",
+        "ssm:AddTagsToResource",
+        "ssm:RemoveTagsFromResource",
+        "ssm:ListTagsForResource",
+      ]
+
+      resources = [
+        "arn:aws:ssm:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:parameter/${var.secrets_manager_prefix}/*",
+      ]
+    }
+  }
+
+  dynamic "statement" {
+    for_each = var.secret_store == "ssm" ? [1] : []
+
+    content {
+      effect = "Allow"
+
+      actions = [
+        "ssm:DescribeParameters",
+      ]
+
+      resources = [
+        "*",
+      ]
+    }
+  }
+
+  dynamic "statement" {
+    for_each = var.state_store == "dynamodb" ? [1] : []
+
+    content {
+      effect = "Allow"
+
+      actions = [
+        "dynamodb:GetItem",
+        "dynamodb:PutItem",
+        "dynamodb:DeleteItem",
+      ]
+
+      resources = [
+        aws_dynamodb_table.state[0].arn,
+      ]
+    }
+  }
+}
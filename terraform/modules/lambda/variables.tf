@@ -31,7 +31,7 @@ variable "s3_key" {
 }
 
 variable "secrets_manager_prefix" {
-  description = "Prefix used for secrets. The Lambda will be allowed to create and write secrets to any secret with this prefix."
+  description = "Prefix used for secrets. The Lambda will be allowed to create and write secrets (or parameters) to any secret with this prefix."
   type        = string
   default     = "concourse"
 }
@@ -62,6 +62,132 @@ variable "key_service_private_key" {
   type        = string
 }
 
+variable "github_base_url" {
+  description = "Base URL of the Github API when using Github Enterprise Server. Defaults to github.com."
+  type        = string
+  default     = ""
+}
+
+variable "github_owner_endpoints" {
+  description = "Map of owners to the Github instance (baseUrl and optional uploadUrl) they are on, if different from the default, and the credentials (tokenService and keyService, each with integrationId and privateKey) of the Github Apps on that instance, and the sshHost (and sshHostKeys) used to verify deploy keys. Can also be a reference to a secret with the JSON (e.g. sm:///concourse-github-lambda/owner-endpoints)."
+  type        = any
+  default     = {}
+}
+
+variable "key_generator" {
+  description = "Backend used to generate deploy key pairs (local or ec2)."
+  type        = string
+  default     = "local"
+}
+
+variable "secret_store" {
+  description = "Backend used to store access tokens and private keys (secretsmanager, ssm or vault)."
+  type        = string
+  default     = "secretsmanager"
+}
+
+variable "vault_address" {
+  description = "Address of the Vault server when using the vault secret store."
+  type        = string
+  default     = ""
+}
+
+variable "vault_mount" {
+  description = "Mount of the KV v2 secrets engine. Defaults to the first segment of the secret path."
+  type        = string
+  default     = ""
+}
+
+variable "vault_auth_method" {
+  description = "Method used to authenticate with Vault (token or aws)."
+  type        = string
+  default     = "token"
+}
+
+variable "vault_token" {
+  description = "Token used for the token auth method. Can also be a reference to a secret (e.g. sm:///concourse-github-lambda/vault-token)."
+  type        = string
+  default     = ""
+}
+
+variable "vault_aws_mount" {
+  description = "Mount of the AWS auth method in Vault."
+  type        = string
+  default     = "aws"
+}
+
+variable "vault_aws_role" {
+  description = "Vault role used for the aws auth method."
+  type        = string
+  default     = ""
+}
+
+variable "vault_aws_server_id" {
+  description = "Value for the X-Vault-AWS-IAM-Server-ID header used for the aws auth method."
+  type        = string
+  default     = ""
+}
+
+variable "state_store" {
+  description = "Backend used to record the rotation state of deploy keys (none or dynamodb). A table is created when using dynamodb."
+  type        = string
+  default     = "none"
+}
+
+variable "reconcile" {
+  description = "Delete deploy keys and secrets for repositories which have been removed from a team's configuration."
+  type        = bool
+  default     = false
+}
+
+variable "removal_delay" {
+  description = "How long to wait after a repository has been removed before deleting its deploy key and secrets."
+  type        = string
+  default     = "24h"
+}
+
+variable "token_grace_period" {
+  description = "How long superseded access tokens remain valid before they are revoked."
+  type        = string
+  default     = "10s"
+}
+
+variable "github_retries" {
+  description = "Number of times requests to Github are retried when they hit a rate limit or fail with a server error."
+  type        = number
+  default     = 3
+}
+
+variable "github_min_remaining" {
+  description = "Do not start on new repositories for an owner when less than this many requests remain for the installation."
+  type        = number
+  default     = 100
+}
+
+variable "secret_retries" {
+  description = "Number of times writes to the secret store are retried when they fail with a transient error."
+  type        = number
+  default     = 3
+}
+
+variable "verify_keys" {
+  description = "Verify that new deploy keys can access their repository over SSH before the old keys are replaced."
+  type        = bool
+  default     = false
+}
+
+variable "verify_ssh_host" {
+  description = "Address of the git host used when verifying deploy keys. Defaults to the host of github_base_url (or github.com) on port 22."
+  type        = string
+  default     = ""
+}
+
+variable "verify_ssh_host_keys" {
+  description = "Public keys of the git host (in authorized_keys format) used when verifying deploy keys."
+  type        = list(string)
+  default     = []
+}
+
 variable "tags" {
   description = "A map of tags (key-value pairs) passed to resources."
   type        = map(string)
@@ -27,6 +27,9 @@ locals {
   team_config = <<EOF
   {
     "name": "${var.name}",
+    "keyType": "${var.key_type}",
+    "permissions": ${jsonencode(var.permissions)},
+    "rotationInterval": ${jsonencode(var.rotation_interval)},
     "repositories": ${jsonencode(var.repositories)}
   }
 EOF
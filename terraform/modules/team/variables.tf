@@ -18,8 +18,26 @@ variable "lambda_arn" {
 }
 
 variable "repositories" {
-  description = "Valid JSON representation of a Team (see Go code)."
-  type        = list(object({ name = string, owner = string, readOnly = bool }))
+  description = "List of repositories (name, owner and readOnly), or selectors which match repositories by glob name (e.g. \"payments-*\"), topics or githubTeam, optionally with exclude patterns (see Go code)."
+  type        = any
+}
+
+variable "key_type" {
+  description = "Default type of deploy key for the team's repositories (rsa-2048, rsa-4096, ecdsa-p256 or ed25519), which can be overridden with keyType on each repository."
+  type        = string
+  default     = "rsa-2048"
+}
+
+variable "permissions" {
+  description = "Permissions granted to the team's access tokens (e.g. { statuses = \"write\" }). Defaults to all permissions of the token service app."
+  type        = map(string)
+  default     = null
+}
+
+variable "rotation_interval" {
+  description = "Interval for rotating the team's deploy keys (e.g. \"24h\" or \"30d\"), which can be overridden with rotationInterval on each repository. Defaults to the interval configured for the lambda."
+  type        = string
+  default     = null
 }
 
 variable "tags" {
@@ -0,0 +1,406 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// Custom metadata key used to store the time a secret was last updated. The remaining
+// custom metadata holds the labels of the secret.
+const vaultLastUpdatedKey = "last_updated"
+
+// VaultConfig for the Vault KV v2 secret store.
+type VaultConfig struct {
+	// Address of the Vault server.
+	Address string
+
+	// Mount of the KV v2 secrets engine. If empty, the first segment of the secret path is used as
+	// the mount, i.e. /concourse/team/secret is written to team/secret in the concourse mount.
+	Mount string
+
+	// AuthMethod is either "token" or "aws".
+	AuthMethod string
+
+	// Token used when AuthMethod is "token".
+	Token string
+
+	// AWSMount, AWSRole and AWSServerID are used when AuthMethod is "aws".
+	AWSMount    string
+	AWSRole     string
+	AWSServerID string
+}
+
+// NewVaultStore returns a SecretStore backed by the Vault KV v2 secrets engine. Secrets are
+// written to the "value" field (which is what Concourse reads), and the time they were last
+// updated is stored in the custom metadata of the secret.
+func NewVaultStore(sess *session.Session, config VaultConfig) (SecretStore, error) {
+	if config.Address == "" {
+		return nil, errors.New("missing vault address")
+	}
+
+	s := &vaultStore{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	switch config.AuthMethod {
+	case "", "token":
+		if config.Token == "" {
+			return nil, errors.New("missing vault token")
+		}
+		s.token = config.Token
+	case "aws":
+		if config.AWSRole == "" {
+			return nil, errors.New("missing vault role for aws auth")
+		}
+		if config.AWSMount == "" {
+			s.config.AWSMount = "aws"
+		}
+		s.sts = sts.New(sess)
+	default:
+		return nil, fmt.Errorf("unsupported vault auth method: '%s'", config.AuthMethod)
+	}
+	return s, nil
+}
+
+type vaultStore struct {
+	config VaultConfig
+	client *http.Client
+	sts    *sts.STS
+
+	mu         sync.Mutex
+	token      string
+	expiration time.Time
+}
+
+// Returns a valid vault token, logging in with AWS IAM auth if required.
+func (s *vaultStore) getToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sts == nil || (s.token != "" && s.expiration.After(time.Now().Add(1*time.Minute))) {
+		return s.token, nil
+	}
+
+	req, _ := s.sts.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	req.SetContext(ctx)
+	if s.config.AWSServerID != "" {
+		req.HTTPRequest.Header.Add("X-Vault-AWS-IAM-Server-ID", s.config.AWSServerID)
+	}
+	if err := req.Sign(); err != nil {
+		return "", fmt.Errorf("failed to sign sts request: %s", err)
+	}
+
+	headers, err := json.Marshal(req.HTTPRequest.Header)
+	if err != nil {
+		return "", err
+	}
+	body, err := ioutil.ReadAll(req.HTTPRequest.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var out struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	err = s.do(ctx, http.MethodPost, path.Join("auth", s.config.AWSMount, "login"), "", map[string]string{
+		"role":                    s.config.AWSRole,
+		"iam_http_request_method": req.HTTPRequest.Method,
+		"iam_request_url":         base64.StdEncoding.EncodeToString([]byte(req.HTTPRequest.URL.String())),
+		"iam_request_headers":     base64.StdEncoding.EncodeToString(headers),
+		"iam_request_body":        base64.StdEncoding.EncodeToString(body),
+	}, &out)
+	if err != nil {
+		return "", fmt.Errorf("failed to login to vault: %s", err)
+	}
+	if out.Auth.ClientToken == "" {
+		return "", errors.New("failed to login to vault: missing client token")
+	}
+
+	s.token = out.Auth.ClientToken
+	s.expiration = time.Now().Add(time.Duration(out.Auth.LeaseDuration) * time.Second)
+	return s.token, nil
+}
+
+// Split a secret name into the KV mount and the path of the secret within the mount.
+func (s *vaultStore) split(name string) (mount, secret string) {
+	name = strings.Trim(name, "/")
+	if s.config.Mount != "" {
+		return strings.Trim(s.config.Mount, "/"), name
+	}
+	if i := strings.Index(name, "/"); i > 0 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// WriteSecret implements SecretStore.
+func (s *vaultStore) WriteSecret(ctx context.Context, name, secret string, labels map[string]string) error {
+	mount, p := s.split(name)
+
+	// The labels are written before the value, and the time it was written is only recorded
+	// once the value has been written, so a failed write never makes the secret look fresh.
+	previous, _, err := s.readMetadata(ctx, name)
+	if err != nil && !errors.Is(err, ErrSecretNotFound) {
+		return err
+	}
+	metadata, _ := splitLabels(labels)
+	delete(metadata, vaultLastUpdatedKey)
+	if ts, ok := previous[vaultLastUpdatedKey]; ok {
+		metadata[vaultLastUpdatedKey] = ts
+	}
+	if err := s.writeMetadata(ctx, name, metadata); err != nil {
+		return err
+	}
+
+	err = s.request(ctx, http.MethodPost, path.Join(mount, "data", p), map[string]interface{}{
+		"data": map[string]string{"value": secret},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// The value has been replaced at this point, and GetMetadata falls back to the time the current
+	// version was created, so failing to record the timestamp must not fail the write.
+	metadata[vaultLastUpdatedKey] = time.Now().UTC().Format(time.RFC3339)
+	_ = s.writeMetadata(ctx, name, metadata)
+	return nil
+}
+
+// GetSecret implements SecretStore.
+func (s *vaultStore) GetSecret(ctx context.Context, name string) (string, error) {
+	mount, p := s.split(name)
+
+	var out struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	if err := s.request(ctx, http.MethodGet, path.Join(mount, "data", p), nil, &out); err != nil {
+		return "", err
+	}
+	return out.Data.Data["value"], nil
+}
+
+// GetMetadata implements SecretStore.
+func (s *vaultStore) GetMetadata(ctx context.Context, name string) (*SecretMetadata, error) {
+	metadata, created, err := s.readMetadata(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var t time.Time
+	if ts, ok := metadata[vaultLastUpdatedKey]; ok {
+		if t, err = time.Parse(time.RFC3339, ts); err != nil {
+			return nil, fmt.Errorf("failed to parse timestamp: %s", err)
+		}
+	}
+	// The timestamp is recorded after the value is written, so the current version can be newer.
+	if created = created.UTC().Truncate(time.Second); created.After(t) {
+		t = created
+	}
+	if t.IsZero() {
+		return nil, fmt.Errorf("failed to find timestamp in custom metadata: %s", name)
+	}
+	delete(metadata, vaultLastUpdatedKey)
+	return &SecretMetadata{Name: name, LastUpdated: t, Labels: metadata}, nil
+}
+
+// SetLabels implements SecretStore. Custom metadata is replaced when written, so the
+// existing metadata is read and merged with the labels.
+func (s *vaultStore) SetLabels(ctx context.Context, name string, labels map[string]string) error {
+	metadata, _, err := s.readMetadata(ctx, name)
+	if err != nil {
+		return err
+	}
+	for k, v := range labels {
+		if k == vaultLastUpdatedKey {
+			continue
+		}
+		if v == "" {
+			delete(metadata, k)
+			continue
+		}
+		metadata[k] = v
+	}
+	return s.writeMetadata(ctx, name, metadata)
+}
+
+// Read the custom metadata of a secret, and the time its current version was created (if any).
+func (s *vaultStore) readMetadata(ctx context.Context, name string) (map[string]string, time.Time, error) {
+	mount, p := s.split(name)
+
+	var out struct {
+		Data struct {
+			CustomMetadata map[string]string `json:"custom_metadata"`
+			CurrentVersion int               `json:"current_version"`
+			Versions       map[string]struct {
+				CreatedTime time.Time `json:"created_time"`
+			} `json:"versions"`
+		} `json:"data"`
+	}
+	if err := s.request(ctx, http.MethodGet, path.Join(mount, "metadata", p), nil, &out); err != nil {
+		return nil, time.Time{}, err
+	}
+	created := out.Data.Versions[strconv.Itoa(out.Data.CurrentVersion)].CreatedTime
+	if out.Data.CustomMetadata == nil {
+		return make(map[string]string), created, nil
+	}
+	return out.Data.CustomMetadata, created, nil
+}
+
+func (s *vaultStore) writeMetadata(ctx context.Context, name string, metadata map[string]string) error {
+	mount, p := s.split(name)
+
+	return s.request(ctx, http.MethodPost, path.Join(mount, "metadata", p), map[string]interface{}{
+		"custom_metadata": metadata,
+	}, nil)
+}
+
+// DeleteSecret implements SecretStore.
+func (s *vaultStore) DeleteSecret(ctx context.Context, name string) error {
+	mount, p := s.split(name)
+
+	// Vault does not return an error when deleting a secret which does not exist.
+	if _, err := s.GetMetadata(ctx, name); errors.Is(err, ErrSecretNotFound) {
+		return err
+	}
+	return s.request(ctx, http.MethodDelete, path.Join(mount, "metadata", p), nil, nil)
+}
+
+// ListSecrets implements SecretStore.
+func (s *vaultStore) ListSecrets(ctx context.Context, prefix string) ([]*SecretMetadata, error) {
+	// List the closest directory and filter on the prefix
+	dir := prefix
+	if !strings.HasSuffix(dir, "/") {
+		dir = path.Dir(dir) + "/"
+	}
+
+	var names []string
+	if err := s.list(ctx, dir, &names); err != nil {
+		return nil, err
+	}
+
+	var secrets []*SecretMetadata
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		metadata, err := s.GetMetadata(ctx, name)
+		if err != nil {
+			// Skip secrets that were not written by the lambda (or deleted since).
+			continue
+		}
+		secrets = append(secrets, metadata)
+	}
+	return secrets, nil
+}
+
+// Recursively list all secrets in a directory.
+func (s *vaultStore) list(ctx context.Context, dir string, names *[]string) error {
+	mount, p := s.split(dir)
+
+	var out struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	if err := s.request(ctx, "LIST", path.Join(mount, "metadata", p), nil, &out); err != nil {
+		if errors.Is(err, ErrSecretNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	for _, key := range out.Data.Keys {
+		if strings.HasSuffix(key, "/") {
+			if err := s.list(ctx, dir+key, names); err != nil {
+				return err
+			}
+			continue
+		}
+		*names = append(*names, dir+key)
+	}
+	return nil
+}
+
+// Perform an authenticated request against the vault API.
+func (s *vaultStore) request(ctx context.Context, method, p string, in, out interface{}) error {
+	token, err := s.getToken(ctx)
+	if err != nil {
+		return err
+	}
+	return s.do(ctx, method, p, token, in, out)
+}
+
+func (s *vaultStore) do(ctx context.Context, method, p, token string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(s.config.Address, "/")+"/v1/"+p, body)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrSecretNotFound
+	}
+	if res.StatusCode >= 300 {
+		var e struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.NewDecoder(res.Body).Decode(&e)
+		return &vaultError{StatusCode: res.StatusCode, Errors: e.Errors}
+	}
+
+	if out == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// vaultError is returned when Vault responds with an error.
+type vaultError struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *vaultError) Error() string {
+	return fmt.Sprintf("vault returned %d: %s", e.StatusCode, strings.Join(e.Errors, ", "))
+}
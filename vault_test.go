@@ -0,0 +1,354 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	handler "github.com/telia-oss/concourse-github-lambda"
+)
+
+// fakeVault is an in-process stand-in for the parts of the Vault API used by the secret store.
+type fakeVault struct {
+	mu       sync.Mutex
+	token    string
+	role     string
+	secrets  map[string]string
+	metadata map[string]map[string]string
+
+	// versions are the number of versions written, and created the time the current version was written.
+	versions map[string]int
+	created  map[string]time.Time
+}
+
+func newFakeVault(token, role string) *fakeVault {
+	return &fakeVault{
+		token:    token,
+		role:     role,
+		secrets:  make(map[string]string),
+		metadata: make(map[string]map[string]string),
+		versions: make(map[string]int),
+		created:  make(map[string]time.Time),
+	}
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	p := strings.TrimPrefix(r.URL.Path, "/v1/")
+	if p == "auth/aws/login" {
+		v.login(w, r)
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string][]string{"errors": {"permission denied"}})
+		return
+	}
+
+	parts := strings.SplitN(p, "/", 3)
+	if len(parts) < 2 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	mount, kind, name := parts[0], parts[1], ""
+	if len(parts) == 3 {
+		name = parts[2]
+	}
+	key := mount + "/" + name
+
+	switch {
+	case kind == "data" && r.Method == http.MethodPost:
+		var in struct {
+			Data map[string]string `json:"data"`
+		}
+		json.NewDecoder(r.Body).Decode(&in)
+		v.secrets[key] = in.Data["value"]
+		if _, ok := v.metadata[key]; !ok {
+			v.metadata[key] = map[string]string{}
+		}
+		v.versions[key]++
+		v.created[key] = time.Now().UTC()
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]int{"version": v.versions[key]}})
+
+	case kind == "data" && r.Method == http.MethodGet:
+		secret, ok := v.secrets[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": map[string]string{"value": secret}}})
+
+	case kind == "metadata" && r.Method == http.MethodPost:
+		var in struct {
+			CustomMetadata map[string]string `json:"custom_metadata"`
+		}
+		json.NewDecoder(r.Body).Decode(&in)
+		v.metadata[key] = in.CustomMetadata
+		w.WriteHeader(http.StatusNoContent)
+
+	case kind == "metadata" && r.Method == http.MethodGet:
+		m, ok := v.metadata[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		versions := make(map[string]interface{})
+		if created, ok := v.created[key]; ok {
+			versions[strconv.Itoa(v.versions[key])] = map[string]interface{}{"created_time": created}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+			"custom_metadata": m,
+			"current_version": v.versions[key],
+			"versions":        versions,
+		}})
+
+	case kind == "metadata" && r.Method == http.MethodDelete:
+		delete(v.secrets, key)
+		delete(v.metadata, key)
+		delete(v.versions, key)
+		delete(v.created, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	case kind == "metadata" && r.Method == "LIST":
+		prefix := strings.TrimSuffix(key, "/") + "/"
+		seen := make(map[string]bool)
+		var keys []string
+		for k := range v.metadata {
+			if !strings.HasPrefix(k, prefix) {
+				continue
+			}
+			rest := strings.TrimPrefix(k, prefix)
+			if i := strings.Index(rest, "/"); i >= 0 {
+				rest = rest[:i+1]
+			}
+			if !seen[rest] {
+				seen[rest] = true
+				keys = append(keys, rest)
+			}
+		}
+		if len(keys) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		sort.Strings(keys)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"keys": keys}})
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (v *fakeVault) login(w http.ResponseWriter, r *http.Request) {
+	var in map[string]string
+	json.NewDecoder(r.Body).Decode(&in)
+
+	u, _ := base64.StdEncoding.DecodeString(in["iam_request_url"])
+	b, _ := base64.StdEncoding.DecodeString(in["iam_request_body"])
+	h, _ := base64.StdEncoding.DecodeString(in["iam_request_headers"])
+
+	var headers map[string][]string
+	json.Unmarshal(h, &headers)
+
+	switch {
+	case in["role"] != v.role,
+		in["iam_http_request_method"] != http.MethodPost,
+		!strings.Contains(string(u), "sts."),
+		!strings.Contains(string(b), "Action=GetCallerIdentity"),
+		len(headers["Authorization"]) == 0:
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string][]string{"errors": {"invalid login"}})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"auth": map[string]interface{}{"client_token": v.token, "lease_duration": 3600},
+	})
+}
+
+func TestVaultStore(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("eu-west-1"),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+	}))
+
+	tests := []struct {
+		description string
+		config      handler.VaultConfig
+	}{
+		{
+			description: "works with token auth",
+			config: handler.VaultConfig{
+				AuthMethod: "token",
+				Token:      "token",
+			},
+		},
+		{
+			description: "works with aws iam auth",
+			config: handler.VaultConfig{
+				AuthMethod: "aws",
+				AWSRole:    "concourse-github-lambda",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			vault := newFakeVault("token", "concourse-github-lambda")
+			server := httptest.NewServer(vault)
+			defer server.Close()
+
+			tc.config.Address = server.URL
+			store, err := handler.NewVaultStore(sess, tc.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, name := range []string{"/concourse/team/repository-deploy-key", "/concourse/team/owner-access-token", "/concourse/other/repository-deploy-key"} {
+				if err := store.WriteSecret(context.Background(), name, "secret", nil); err != nil {
+					t.Fatalf("failed to write secret: %s", err)
+				}
+			}
+			if got, want := vault.secrets["concourse/team/repository-deploy-key"], "secret"; got != want {
+				t.Errorf("got secret %q, want %q", got, want)
+			}
+			secret, err := store.GetSecret(context.Background(), "/concourse/team/repository-deploy-key")
+			if err != nil {
+				t.Fatalf("failed to get secret: %s", err)
+			}
+			if got, want := secret, "secret"; got != want {
+				t.Errorf("got secret %q, want %q", got, want)
+			}
+
+			metadata, err := store.GetMetadata(context.Background(), "/concourse/team/repository-deploy-key")
+			if err != nil {
+				t.Fatalf("failed to get metadata: %s", err)
+			}
+			if time.Since(metadata.LastUpdated) > time.Minute {
+				t.Errorf("unexpected last updated: %s", metadata.LastUpdated)
+			}
+
+			secrets, err := store.ListSecrets(context.Background(), "/concourse/team/")
+			if err != nil {
+				t.Fatalf("failed to list secrets: %s", err)
+			}
+			var names []string
+			for _, s := range secrets {
+				names = append(names, s.Name)
+			}
+			if got, want := strings.Join(names, ","), "/concourse/team/owner-access-token,/concourse/team/repository-deploy-key"; got != want {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", got, want)
+			}
+
+			if err := store.DeleteSecret(context.Background(), "/concourse/team/repository-deploy-key"); err != nil {
+				t.Fatalf("failed to delete secret: %s", err)
+			}
+			if _, err := store.GetMetadata(context.Background(), "/concourse/team/repository-deploy-key"); !errors.Is(err, handler.ErrSecretNotFound) {
+				t.Errorf("expected not found error, got: %v", err)
+			}
+			if _, err := store.GetSecret(context.Background(), "/concourse/team/repository-deploy-key"); !errors.Is(err, handler.ErrSecretNotFound) {
+				t.Errorf("expected not found error, got: %v", err)
+			}
+			if err := store.DeleteSecret(context.Background(), "/concourse/team/repository-deploy-key"); !errors.Is(err, handler.ErrSecretNotFound) {
+				t.Errorf("expected not found error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestVaultStoreConfiguredMount(t *testing.T) {
+	vault := newFakeVault("token", "")
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Mount: "secret", Token: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := store.WriteSecret(context.Background(), "/concourse/team/secret", "value", nil); err != nil {
+		t.Fatalf("failed to write secret: %s", err)
+	}
+	if _, ok := vault.secrets["secret/concourse/team/secret"]; !ok {
+		t.Errorf("secret was not written to the configured mount: %v", vault.secrets)
+	}
+}
+
+func TestVaultStoreWriteOrder(t *testing.T) {
+	lastUpdated := time.Now().Add(-2 * time.Hour).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		description     string
+		kind            string
+		after           int
+		expectError     bool
+		expectedSecret  string
+		expectedUpdated bool
+	}{
+		{
+			description:    "does not write the value when the labels cannot be written",
+			kind:           "metadata",
+			expectError:    true,
+			expectedSecret: "previous",
+		},
+		{
+			description:    "does not record the time when the value cannot be written",
+			kind:           "data",
+			expectError:    true,
+			expectedSecret: "previous",
+		},
+		{
+			description:     "uses the time of the current version when the time cannot be recorded",
+			kind:            "metadata",
+			after:           1,
+			expectedSecret:  "secret",
+			expectedUpdated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			vault := &unavailableVault{fakeVault: newFakeVault("token", ""), kind: tc.kind, match: "deploy-key", after: tc.after, failures: 1}
+			vault.secrets["concourse/team/repository-deploy-key"] = "previous"
+			vault.metadata["concourse/team/repository-deploy-key"] = map[string]string{
+				"last_updated": lastUpdated.Format(time.RFC3339),
+				"team":         "previous-team",
+			}
+			server := httptest.NewServer(vault)
+			defer server.Close()
+
+			store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = store.WriteSecret(context.Background(), "/concourse/team/repository-deploy-key", "secret", map[string]string{"team": "team"})
+			if got, want := err != nil, tc.expectError; got != want {
+				t.Fatalf("got error %v, want error %t", err, want)
+			}
+			if got, want := vault.secrets["concourse/team/repository-deploy-key"], tc.expectedSecret; got != want {
+				t.Errorf("got secret %q, want %q", got, want)
+			}
+
+			metadata, err := store.GetMetadata(context.Background(), "/concourse/team/repository-deploy-key")
+			if err != nil {
+				t.Fatalf("failed to get metadata: %s", err)
+			}
+			if got, want := !metadata.LastUpdated.Equal(lastUpdated), tc.expectedUpdated; got != want {
+				t.Errorf("got last updated %s (previously %s), want it changed %t", metadata.LastUpdated, lastUpdated, want)
+			}
+		})
+	}
+}
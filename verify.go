@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyVerifier checks that a newly created deploy key works before the old key is deleted.
+type KeyVerifier interface {
+	// VerifyKey returns an error if the private key cannot read the repository (or write to it, unless it is read only).
+	VerifyKey(ctx context.Context, repository Repository, privateKey string) error
+}
+
+// SSHVerifierConfig configures verification of deploy keys against the git host over SSH.
+type SSHVerifierConfig struct {
+	// Host is the address of the git host (defaults to github.com:22).
+	Host string
+
+	// User defaults to git.
+	User string
+
+	// HostKeys are the public keys of the git host in authorized_keys format (e.g. "ssh-ed25519 AAAA...").
+	HostKeys []string
+
+	// Owners on other git hosts than Host (i.e. owners on other Github instances). Keys for owners
+	// whose Host is empty are not verified.
+	Owners map[string]SSHHost
+
+	// Timeout for each attempt (defaults to 10 seconds).
+	Timeout time.Duration
+
+	// Attempts is the number of times the key is tried, with Wait in between, since a new key can take a
+	// moment before the git host accepts it. Defaults to 3 attempts, 2 seconds apart.
+	Attempts int
+	Wait     time.Duration
+}
+
+// SSHHost is the address and public keys of a git host.
+type SSHHost struct {
+	Host     string
+	HostKeys []string
+}
+
+// NewSSHKeyVerifier returns a KeyVerifier which opens an SSH session to the git host with the new
+// key, and asks for the refs of the repository using git-upload-pack (read only keys) or git-receive-pack.
+func NewSSHKeyVerifier(config SSHVerifierConfig) (KeyVerifier, error) {
+	if config.Host == "" {
+		config.Host = "github.com:22"
+	}
+	if config.User == "" {
+		config.User = "git"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.Attempts == 0 {
+		config.Attempts = 3
+	}
+	if config.Wait == 0 {
+		config.Wait = 2 * time.Second
+	}
+
+	host, err := newSSHHost(config.Host, config.HostKeys)
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[string]*sshHost, len(config.Owners))
+	for owner, h := range config.Owners {
+		if h.Host == "" {
+			owners[strings.ToLower(owner)] = nil
+			continue
+		}
+		if owners[strings.ToLower(owner)], err = newSSHHost(h.Host, h.HostKeys); err != nil {
+			return nil, fmt.Errorf("%s: %s", owner, err)
+		}
+	}
+	return &sshKeyVerifier{config: config, host: host, owners: owners}, nil
+}
+
+type sshKeyVerifier struct {
+	config SSHVerifierConfig
+	host   *sshHost
+	owners map[string]*sshHost
+}
+
+type sshHost struct {
+	address  string
+	hostKeys [][]byte
+}
+
+func newSSHHost(address string, hostKeys []string) (*sshHost, error) {
+	// Default to the SSH port
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "22")
+	}
+
+	h := &sshHost{address: address}
+	for _, k := range hostKeys {
+		if strings.TrimSpace(k) == "" {
+			continue
+		}
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse host key: %s", err)
+		}
+		h.hostKeys = append(h.hostKeys, key.Marshal())
+	}
+	if len(h.hostKeys) == 0 {
+		return nil, fmt.Errorf("missing host keys for the git host: %s", address)
+	}
+	return h, nil
+}
+
+// Returns the git host for an owner, or nil if keys for the owner should not be verified.
+func (v *sshKeyVerifier) hostFor(owner string) *sshHost {
+	if h, ok := v.owners[strings.ToLower(owner)]; ok {
+		return h
+	}
+	return v.host
+}
+
+// VerifyKey implements KeyVerifier.
+func (v *sshKeyVerifier) VerifyKey(ctx context.Context, repository Repository, privateKey string) error {
+	host := v.hostFor(repository.Owner)
+	if host == nil {
+		return nil
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return fmt.Errorf("failed to parse private key: %s", err)
+	}
+	for attempt := 1; ; attempt++ {
+		err = v.verify(ctx, host, repository, signer)
+		if err == nil || attempt >= v.config.Attempts {
+			return err
+		}
+		timer := time.NewTimer(v.config.Wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+func (v *sshKeyVerifier) verify(ctx context.Context, host *sshHost, repository Repository, signer ssh.Signer) error {
+	dialer := net.Dialer{Timeout: v.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", host.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to '%s': %s", host.address, err)
+	}
+	deadline := time.Now().Add(v.config.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	c, chans, reqs, err := ssh.NewClientConn(conn, host.address, &ssh.ClientConfig{
+		User:            v.config.User,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: host.checkHostKey,
+	})
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to authenticate with '%s': %s", host.address, err)
+	}
+	client := ssh.NewClient(c, chans, reqs)
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return fmt.Errorf("failed to open session: %s", err)
+	}
+	defer session.Close()
+
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	command := "git-receive-pack"
+	if repository.ReadOnly {
+		command = "git-upload-pack"
+	}
+	if err := session.Start(fmt.Sprintf("%s '%s/%s.git'", command, repository.Owner, repository.Name)); err != nil {
+		return fmt.Errorf("failed to run %s: %s", command, err)
+	}
+
+	// The refs are advertised (as pkt-lines starting with a hex encoded length) if the key has access.
+	// Otherwise the host explains why on stderr and exits.
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(stdout, header); err != nil {
+		if werr := session.Wait(); werr != nil {
+			err = werr
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s failed: %s", command, msg)
+		}
+		return fmt.Errorf("%s failed: %s", command, err)
+	}
+	if _, err := strconv.ParseUint(string(header), 16, 16); err != nil {
+		return fmt.Errorf("unexpected response from %s: %q", command, header)
+	}
+
+	// Tell the host that we do not want anything (a flush packet).
+	stdin.Write([]byte("0000"))
+	stdin.Close()
+	return nil
+}
+
+func (h *sshHost) checkHostKey(hostname string, remote net.Addr, key ssh.PublicKey) error {
+	for _, k := range h.hostKeys {
+		if bytes.Equal(k, key.Marshal()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown host key for '%s': %s", hostname, ssh.FingerprintSHA256(key))
+}
@@ -0,0 +1,447 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v29/github"
+	logrus "github.com/sirupsen/logrus/hooks/test"
+	handler "github.com/telia-oss/concourse-github-lambda"
+	"golang.org/x/crypto/ssh"
+)
+
+// fakeGitHost is an in-process stand-in for the SSH endpoint of Github. Keys are authorized
+// by looking them up among the deploy keys of the fake Github API.
+type fakeGitHost struct {
+	github   *fakeGithub
+	hostKey  ssh.Signer
+	listener net.Listener
+
+	mu       sync.Mutex
+	commands []string
+	hidden   map[string]bool
+}
+
+func newFakeGitHost(t *testing.T, fake *fakeGithub) *fakeGitHost {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate host key: %s", err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %s", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+
+	h := &fakeGitHost{github: fake, hostKey: signer, listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go h.serve(conn)
+		}
+	}()
+	return h
+}
+
+// The address and host key (in authorized_keys format) of the host.
+func (h *fakeGitHost) Addr() string { return h.listener.Addr().String() }
+func (h *fakeGitHost) HostKey() string {
+	return string(ssh.MarshalAuthorizedKey(h.hostKey.PublicKey()))
+}
+func (h *fakeGitHost) Close() { h.listener.Close() }
+
+func (h *fakeGitHost) Commands() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.commands...)
+}
+
+// Returns the deploy key matching the public key on a repository (any repository if empty).
+func (h *fakeGitHost) findKey(repository string, public ssh.PublicKey) *github.Key {
+	h.github.mu.Lock()
+	defer h.github.mu.Unlock()
+	for name, keys := range h.github.keys {
+		if repository != "" && name != repository {
+			continue
+		}
+		for _, key := range keys {
+			k, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.GetKey()))
+			if err == nil && bytes.Equal(k.Marshal(), public.Marshal()) {
+				return key
+			}
+		}
+	}
+	return nil
+}
+
+func (h *fakeGitHost) serve(conn net.Conn) {
+	defer conn.Close()
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if meta.User() != "git" || h.findKey("", key) == nil {
+				return nil, fmt.Errorf("unknown key")
+			}
+			return &ssh.Permissions{Extensions: map[string]string{"key": string(key.Marshal())}}, nil
+		},
+	}
+	config.AddHostKey(h.hostKey)
+
+	server, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	defer server.Close()
+	go ssh.DiscardRequests(reqs)
+
+	public, err := ssh.ParsePublicKey([]byte(server.Permissions.Extensions["key"]))
+	if err != nil {
+		return
+	}
+	for c := range chans {
+		if c.ChannelType() != "session" {
+			c.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := c.Accept()
+		if err != nil {
+			return
+		}
+		go h.session(channel, requests, public)
+	}
+}
+
+func (h *fakeGitHost) session(channel ssh.Channel, requests <-chan *ssh.Request, public ssh.PublicKey) {
+	defer channel.Close()
+	for req := range requests {
+		if req.Type != "exec" {
+			req.Reply(false, nil)
+			continue
+		}
+		var exec struct{ Command string }
+		if err := ssh.Unmarshal(req.Payload, &exec); err != nil {
+			req.Reply(false, nil)
+			return
+		}
+		req.Reply(true, nil)
+
+		h.mu.Lock()
+		h.commands = append(h.commands, exec.Command)
+		h.mu.Unlock()
+
+		status := h.exec(channel, exec.Command, public)
+		channel.SendRequest("exit-status", false, ssh.Marshal(&struct{ Status uint32 }{status}))
+		return
+	}
+}
+
+func (h *fakeGitHost) exec(channel ssh.Channel, command string, public ssh.PublicKey) uint32 {
+	parts := strings.SplitN(command, " ", 2)
+	if len(parts) != 2 || (parts[0] != "git-upload-pack" && parts[0] != "git-receive-pack") {
+		fmt.Fprintf(channel.Stderr(), "Invalid command: %s\n", command)
+		return 1
+	}
+	repository := strings.TrimSuffix(strings.Trim(parts[1], "'"), ".git")
+
+	h.mu.Lock()
+	hidden := h.hidden[repository]
+	h.mu.Unlock()
+
+	key := h.findKey(repository, public)
+	if key == nil || hidden {
+		fmt.Fprintln(channel.Stderr(), "ERROR: Repository not found.")
+		return 1
+	}
+	if parts[0] == "git-receive-pack" && key.GetReadOnly() {
+		fmt.Fprintln(channel.Stderr(), "ERROR: The key you are authenticating with has been marked as read only.")
+		return 1
+	}
+
+	// Advertise a single ref and wait for the client to hang up.
+	ref := "0000000000000000000000000000000000000000 capabilities^{}\x00\n"
+	fmt.Fprintf(channel, "%04x%s0000", len(ref)+4, ref)
+	io.Copy(ioutil.Discard, io.LimitReader(channel, 4))
+	return 0
+}
+
+func TestSSHKeyVerifier(t *testing.T) {
+	tests := []struct {
+		description   string
+		keyRepository string
+		keyReadOnly   bool
+		readOnly      bool
+		otherHostKey  bool
+		expectedError string
+	}{
+		{
+			description:   "read only keys can read",
+			keyRepository: "telia-oss/test-repository",
+			keyReadOnly:   true,
+			readOnly:      true,
+		},
+		{
+			description:   "read/write keys can write",
+			keyRepository: "telia-oss/test-repository",
+			readOnly:      false,
+		},
+		{
+			description:   "fails if a read/write key is read only",
+			keyRepository: "telia-oss/test-repository",
+			keyReadOnly:   true,
+			readOnly:      false,
+			expectedError: "git-receive-pack failed: ERROR: The key you are authenticating with has been marked as read only.",
+		},
+		{
+			description:   "fails if the key belongs to another repository",
+			keyRepository: "telia-oss/other-repository",
+			readOnly:      true,
+			expectedError: "git-upload-pack failed: ERROR: Repository not found.",
+		},
+		{
+			description:   "fails if the key is unknown",
+			readOnly:      true,
+			expectedError: "unable to authenticate",
+		},
+		{
+			description:   "fails if the host key is unknown",
+			keyRepository: "telia-oss/test-repository",
+			readOnly:      true,
+			otherHostKey:  true,
+			expectedError: "unknown host key",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			fake := newFakeGithub(100)
+			host := newFakeGitHost(t, fake)
+			defer host.Close()
+
+			private, public, err := handler.NewLocalKeyGenerator().GenerateKeyPair(context.Background(), "test-key", handler.KeyTypeEd25519)
+			if err != nil {
+				t.Fatalf("failed to generate key: %s", err)
+			}
+			if tc.keyRepository != "" {
+				fake.keys[tc.keyRepository] = []*github.Key{{ID: github.Int64(1), Key: github.String(public), ReadOnly: github.Bool(tc.keyReadOnly)}}
+			}
+
+			hostKey := host.HostKey()
+			if tc.otherHostKey {
+				other := newFakeGitHost(t, fake)
+				defer other.Close()
+				hostKey = other.HostKey()
+			}
+			verifier, err := handler.NewSSHKeyVerifier(handler.SSHVerifierConfig{
+				Host:     host.Addr(),
+				HostKeys: []string{hostKey},
+				Attempts: 1,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = verifier.VerifyKey(context.Background(), handler.Repository{Name: "test-repository", Owner: "telia-oss", ReadOnly: tc.readOnly}, private)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("expected error containing %q, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSSHKeyVerifierOwners(t *testing.T) {
+	fake := newFakeGithub(100)
+
+	// The default git host is not reachable, so only owners on other hosts can be verified.
+	unreachable := newFakeGitHost(t, fake)
+	unreachable.Close()
+	host := newFakeGitHost(t, fake)
+	defer host.Close()
+
+	private, public, err := handler.NewLocalKeyGenerator().GenerateKeyPair(context.Background(), "test-key", handler.KeyTypeEd25519)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+	fake.keys["example-org/test-repository"] = []*github.Key{{ID: github.Int64(1), Key: github.String(public), ReadOnly: github.Bool(true)}}
+
+	verifier, err := handler.NewSSHKeyVerifier(handler.SSHVerifierConfig{
+		Host:     unreachable.Addr(),
+		HostKeys: []string{unreachable.HostKey()},
+		Owners: map[string]handler.SSHHost{
+			"Example-Org": {Host: host.Addr(), HostKeys: []string{host.HostKey()}},
+			"skipped-org": {},
+		},
+		Attempts: 1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		owner         string
+		expectedError string
+	}{
+		{owner: "example-org"},
+		{owner: "skipped-org"},
+		{owner: "telia-oss", expectedError: "failed to connect to '" + unreachable.Addr() + "'"},
+	}
+	for _, tc := range tests {
+		err := verifier.VerifyKey(context.Background(), handler.Repository{Name: "test-repository", Owner: tc.owner, ReadOnly: true}, private)
+		if tc.expectedError == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %s", tc.owner, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+			t.Errorf("%s: expected error containing %q, got: %v", tc.owner, tc.expectedError, err)
+		}
+	}
+	if got, want := host.Commands(), []string{"git-upload-pack 'example-org/test-repository.git'"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got commands %v, want %v", got, want)
+	}
+
+	_, err = handler.NewSSHKeyVerifier(handler.SSHVerifierConfig{
+		HostKeys: []string{host.HostKey()},
+		Owners:   map[string]handler.SSHHost{"example-org": {Host: "github.example.com"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "missing host keys for the git host: github.example.com:22") {
+		t.Errorf("expected an error for the missing host keys, got: %v", err)
+	}
+}
+
+func TestHandlerVerifiesKeys(t *testing.T) {
+	tests := []struct {
+		description     string
+		hidden          bool
+		expectedStatus  handler.Status
+		expectedActions []handler.ActionType
+		expectedKeys    []int64
+	}{
+		{
+			description:     "replaces the key once the new key has been verified",
+			expectedStatus:  handler.StatusRotated,
+			expectedActions: []handler.ActionType{handler.ActionWriteSecret, handler.ActionCreateKey, handler.ActionWriteSecret, handler.ActionDeleteKey},
+			expectedKeys:    []int64{1001},
+		},
+		{
+			description:     "keeps the old key when the new key does not work",
+			hidden:          true,
+			expectedStatus:  handler.StatusFailed,
+			expectedActions: []handler.ActionType{handler.ActionWriteSecret, handler.ActionCreateKey, handler.ActionDeleteKey},
+			expectedKeys:    []int64{1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			fake := newFakeGithub(100)
+			fake.repositories["telia-oss/test-repository"] = &github.Repository{ID: github.Int64(1), Name: github.String("test-repository")}
+			fake.keys["telia-oss/test-repository"] = []*github.Key{
+				{ID: github.Int64(1), Title: github.String("concourse-test-team-deploy-key"), Key: github.String("ssh-ed25519 old-key")},
+			}
+			client, stop := fake.Start(t)
+			defer stop()
+
+			// Hiding the repository on the git host simulates a new key which does not work.
+			host := newFakeGitHost(t, fake)
+			defer host.Close()
+			host.hidden = map[string]bool{"telia-oss/test-repository": tc.hidden}
+
+			vault := newFakeVault("token", "")
+			vault.secrets["concourse/test-team/test-repository-deploy-key"] = "old-private-key"
+			vault.metadata["concourse/test-team/test-repository-deploy-key"] = map[string]string{
+				"last_updated": time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+			}
+			server := httptest.NewServer(vault)
+			defer server.Close()
+
+			store, err := handler.NewVaultStore(nil, handler.VaultConfig{Address: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			verifier, err := handler.NewSSHKeyVerifier(handler.SSHVerifierConfig{
+				Host:     host.Addr(),
+				HostKeys: []string{host.HostKey()},
+				Attempts: 1,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			services := &handler.GithubApp{
+				App:           client.Apps,
+				Installations: map[string]int64{"telia-oss": 1},
+				Clients: map[string]*handler.GithubClient{
+					"telia-oss": {Apps: client.Apps, Repos: client.Repositories, Expiration: time.Now().Add(1 * time.Hour)},
+				},
+				TokenClient: func(owner, token string) (handler.AppsClient, error) {
+					return client.Apps, nil
+				},
+			}
+			manager := handler.NewTestManager(store, handler.NewLocalKeyGenerator(), services, services, nil, verifier)
+			logger, _ := logrus.NewNullLogger()
+			handle := handler.New(manager, handler.Config{
+				TokenPath: "/concourse/{{.Team}}/{{.Owner}}-access-token",
+				KeyPath:   "/concourse/{{.Team}}/{{.Repository}}-deploy-key",
+				KeyTitle:  "concourse-{{.Team}}-deploy-key",
+				Lenient:   true,
+			}, logger)
+
+			result, err := handle(context.Background(), handler.Team{
+				Name:         "test-team",
+				Repositories: []handler.Repository{{Name: "test-repository", Owner: "telia-oss", ReadOnly: true, Force: true}},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			r := result.Repositories[0]
+			if got, want := r.Status, tc.expectedStatus; got != want {
+				t.Errorf("got status %s, want %s: %s", got, want, r.Reason)
+			}
+			var actions []handler.ActionType
+			for _, a := range r.Actions {
+				actions = append(actions, a.Type)
+			}
+			if !reflect.DeepEqual(actions, tc.expectedActions) {
+				t.Errorf("got actions %v, want %v", actions, tc.expectedActions)
+			}
+			var keys []int64
+			for _, key := range fake.keys["telia-oss/test-repository"] {
+				keys = append(keys, key.GetID())
+			}
+			if !reflect.DeepEqual(keys, tc.expectedKeys) {
+				t.Errorf("got keys %v, want %v", keys, tc.expectedKeys)
+			}
+			if got, want := host.Commands(), []string{"git-upload-pack 'telia-oss/test-repository.git'"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got commands %v, want %v", got, want)
+			}
+			if got := vault.secrets["concourse/test-team/test-repository-deploy-key"]; (got == "old-private-key") != tc.hidden {
+				t.Errorf("unexpected private key: %s", got)
+			}
+		})
+	}
+}